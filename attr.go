@@ -17,9 +17,12 @@ func (t Tensor[T]) Iter() Iterator[T] {
 	}
 }
 
-// Ravel returns the Tensor's view in its data buffer.
+// Ravel returns the Tensor's elements in row-major order, as a view
+// in its data buffer if the Tensor is contiguous, or as a copy otherwise.
+// Writes through the returned slice only reach the Tensor if IsContiguous
+// is true.
 func (t Tensor[T]) Ravel() []T {
-	return t.data[t.offset : t.offset+t.Numel()]
+	return t.gather(0, t.Numel(), nil)
 }
 
 // Scalar returns the scalar equivalent of a rank 0 Tensor.
@@ -64,9 +67,10 @@ func (t Tensor[T]) Offset() int {
 
 // Size returns the Tensor's number of dimensions at
 // the given axis.
-// Panics if axis is out of (0, rank) bounds.
+// A negative axis counts from the last axis.
+// Panics if axis is out of [-rank, rank) bounds.
 func (t Tensor[T]) Size(axis int) int {
-	err := verifyAxisBounds(axis, len(t.shape))
+	axis, err := normAxis("Size", axis, len(t.shape))
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,233 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"sync"
+
+	"github.com/vorduin/slices"
+)
+
+// copyRange copies the elements of the Tensor whose row-major index lies
+// within [start, end) into the out buffer, laid out with the given stride
+// from the given offset. Each of the Tensor's rows of length row is
+// contiguous in the out buffer, where rows are spaced by outRow.
+func copyRange[T Number](out []T, stride []int, offset int, t Tensor[T], row, outRow, start, end int) {
+	if !t.IsContiguous() {
+		walkLayouts(t.shape, [][]int{stride, t.stride}, []int{offset, t.offset}, start, end, func(pos []int) {
+			out[pos[0]] = t.data[pos[1]]
+		})
+		return
+	}
+
+	for i := start; i < end; {
+		r, c := i/row, i%row
+
+		n := row - c
+		if n > end-i {
+			n = end - i
+		}
+
+		dst := offset + r*outRow + c
+		copy(out[dst:dst+n], t.data[t.offset+i:t.offset+i+n])
+		i += n
+	}
+}
+
+// handleConcat processes a concatenation of the Tensors along the
+// given axis into the out buffer accordingly, copying each Tensor once.
+// The Tensors are split across goroutines if there are enough of them,
+// otherwise each Tensor's copy is split across goroutines.
+func handleConcat[T Number](out []T, shape []int, axis int, ts []Tensor[T], nCPU int) {
+	stride := configStride(shape)
+	outRow := slices.Prod(shape[axis:])
+
+	offsets := make([]int, len(ts))
+	for i := 1; i < len(ts); i++ {
+		offsets[i] = offsets[i-1] + ts[i-1].shape[axis]*stride[axis]
+	}
+
+	var wg sync.WaitGroup
+
+	if len(ts) >= nCPU {
+		for i := 0; i < nCPU; i++ {
+			min := (i * len(ts) / nCPU)
+			max := ((i + 1) * len(ts)) / nCPU
+
+			wg.Add(1)
+			go func(min, max int) {
+				for j := min; j < max; j++ {
+					t := ts[j]
+					copyRange(out, stride, offsets[j], t, slices.Prod(t.shape[axis:]), outRow, 0, t.Numel())
+				}
+
+				wg.Done()
+			}(min, max)
+		}
+
+		wg.Wait()
+		return
+	}
+
+	for j, t := range ts {
+		row := slices.Prod(t.shape[axis:])
+
+		for i := 0; i < nCPU; i++ {
+			min := (i * t.Numel() / nCPU)
+			max := ((i + 1) * t.Numel()) / nCPU
+
+			wg.Add(1)
+			go func(t Tensor[T], offset, min, max int) {
+				copyRange(out, stride, offset, t, row, outRow, min, max)
+
+				wg.Done()
+			}(t, offsets[j], min, max)
+		}
+
+		wg.Wait()
+	}
+}
+
+// concat processes a concatenation of the Tensors along the given axis,
+// with errors naming the given method.
+func concat[T Number](method string, axis int, ts []Tensor[T]) Tensor[T] {
+	if len(ts) == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrNoOperands)
+		} else {
+			return Tensor[T]{
+				Err: ErrNoOperands,
+			}
+		}
+	}
+
+	for _, t := range ts {
+		if t.Err != nil {
+			if EnvConfig.Interactive {
+				panic(t.Err)
+			} else {
+				return Tensor[T]{
+					Err: t.Err,
+				}
+			}
+		}
+	}
+
+	axis, err := normAxis(method, axis, len(ts[0].shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[T]{
+				Err: err,
+			}
+		}
+	}
+
+	shape := slices.Clone(ts[0].shape)
+	for i, t := range ts[1:] {
+		if len(t.shape) != len(shape) ||
+			!slices.Equal(t.shape[:axis], shape[:axis]) || !slices.Equal(t.shape[axis+1:], shape[axis+1:]) {
+			err := &OperandError{
+				Method:  method,
+				Operand: i + 1,
+				Err:     ErrShapeMismatch,
+			}
+
+			if EnvConfig.Interactive {
+				panic(err)
+			} else {
+				return Tensor[T]{
+					Err: err,
+				}
+			}
+		}
+
+		shape[axis] += t.shape[axis]
+	}
+
+	data := slices.WithLen[T](slices.Prod(shape))
+	handleConcat(data, shape, axis, ts, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Concat concatenates the given Tensors along the given axis into
+// a new Tensor. The Tensors must all have the same shape, except
+// along the concatenation axis.
+func Concat[T Number](axis int, ts ...Tensor[T]) Tensor[T] {
+	return concat("Concat", axis, ts)
+}
+
+// stack processes a stacking of the Tensors along a new axis,
+// with errors naming the given method.
+func stack[T Number](method string, axis int, ts []Tensor[T]) Tensor[T] {
+	if len(ts) == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrNoOperands)
+		} else {
+			return Tensor[T]{
+				Err: ErrNoOperands,
+			}
+		}
+	}
+
+	for _, t := range ts {
+		if t.Err != nil {
+			if EnvConfig.Interactive {
+				panic(t.Err)
+			} else {
+				return Tensor[T]{
+					Err: t.Err,
+				}
+			}
+		}
+	}
+
+	axis, err := normInsertAxis(method, axis, len(ts[0].shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[T]{
+				Err: err,
+			}
+		}
+	}
+
+	views := make([]Tensor[T], len(ts))
+	for i, t := range ts {
+		if !slices.Equal(t.shape, ts[0].shape) {
+			err := &OperandError{
+				Method:  method,
+				Operand: i,
+				Err:     ErrShapeMismatch,
+			}
+
+			if EnvConfig.Interactive {
+				panic(err)
+			} else {
+				return Tensor[T]{
+					Err: err,
+				}
+			}
+		}
+
+		views[i] = t.Unsqueeze(axis)
+	}
+
+	return concat(method, axis, views)
+}
+
+// StackN stacks the given Tensors together along a new axis, within
+// [-rank-1, rank] bounds, into a new Tensor. The Tensors must all have
+// the same shape.
+func StackN[T Number](axis int, ts ...Tensor[T]) Tensor[T] {
+	return stack("StackN", axis, ts)
+}
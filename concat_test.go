@@ -0,0 +1,87 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestConcat(t *testing.T) {
+	a := nune.Range[int](0, 4, 1).Reshape(2, 2)
+	b := nune.Range[int](4, 10, 1).Reshape(2, 3)
+	c := nune.Range[int](10, 12, 1).Reshape(2, 1)
+
+	out := nune.Concat(-1, a, b.Permute(1, 0).Permute(1, 0), c)
+	if !slices.Equal(out.Ravel(), []int{0, 1, 4, 5, 6, 10, 2, 3, 7, 8, 9, 11}) {
+		t.Error("concatenation along the last axis is incorrect")
+	}
+
+	if !slices.Equal(out.Shape(), []int{2, 6}) {
+		t.Error("concatenation along the last axis has the wrong shape")
+	}
+
+	// concatenating strided views along the first axis
+	out = nune.Concat(0, a.Permute(1, 0), b.Get(nune.All, nune.S(nune.None, nune.None, 2)))
+	if !slices.Equal(out.Ravel(), []int{0, 2, 1, 3, 4, 6, 7, 9}) {
+		t.Error("concatenation of views is incorrect")
+	}
+
+	var err *nune.OperandError
+	if !errors.As(nune.Concat(0, a, a, b).Err, &err) || err.Operand != 2 || !errors.Is(err, nune.ErrShapeMismatch) {
+		t.Error("concatenation of incompatible tensors does not report the operand")
+	}
+
+	if nune.Concat[int](0).Err == nil {
+		t.Error("concatenated no tensors")
+	}
+}
+
+func TestConcatNumCPU(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	ts := make([]nune.Tensor[int], 3)
+	for i := range ts {
+		ts[i] = nune.Range[int](i*10, i*10+10, 1).Reshape(2, 5)
+	}
+
+	nune.EnvConfig.NumCPU = 1
+	want := nune.Concat(1, ts...)
+
+	// fewer tensors than goroutines splits each copy
+	nune.EnvConfig.NumCPU = 4
+	if !slices.Equal(nune.Concat(1, ts...).Ravel(), want.Ravel()) {
+		t.Error("concatenation split across goroutines is incorrect")
+	}
+}
+
+func TestStackN(t *testing.T) {
+	a := nune.Range[int](0, 3, 1)
+	b := nune.Range[int](3, 6, 1)
+
+	out := nune.StackN(-1, a, b, a)
+	if !slices.Equal(out.Ravel(), []int{0, 3, 0, 1, 4, 1, 2, 5, 2}) || !slices.Equal(out.Shape(), []int{3, 3}) {
+		t.Error("stack along a new last axis is incorrect")
+	}
+
+	out = a.Stack(b, 0)
+	if !slices.Equal(out.Ravel(), []int{0, 1, 2, 3, 4, 5}) || !slices.Equal(out.Shape(), []int{2, 3}) {
+		t.Error("stack along a new first axis is incorrect")
+	}
+
+	var err *nune.OperandError
+	if !errors.As(nune.StackN(0, a, b, nune.Range[int](0, 4, 1)).Err, &err) || err.Operand != 2 {
+		t.Error("stack of incompatible tensors does not report the operand")
+	}
+}
+
+func BenchmarkConcat(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		nune.Concat(0, tensor, tensor)
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// diagonalAxes returns a view over the Tensor's diagonal as Diagonal does,
+// with errors naming the given method.
+func (t Tensor[T]) diagonalAxes(method string, offset, axis1, axis2 int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	axes, err := normAxes(method, len(t.shape), axis1, axis2)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	a, b := axes[0], axes[1]
+	rows, cols := t.shape[a], t.shape[b]
+	start := t.offset
+
+	// the diagonal starts at the first row or column,
+	// depending on the sign of the offset
+	n := rows
+	if offset >= 0 {
+		if cols-offset < n {
+			n = cols - offset
+		}
+		start += offset * t.stride[b]
+	} else {
+		n += offset
+		if cols < n {
+			n = cols
+		}
+		start -= offset * t.stride[a]
+	}
+
+	if n <= 0 {
+		if EnvConfig.Interactive {
+			panic(ErrBadShape)
+		} else {
+			t.Err = ErrBadShape
+			return t
+		}
+	}
+
+	shape := make([]int, 0, len(t.shape)-1)
+	stride := make([]int, 0, len(t.shape)-1)
+	for i := range t.shape {
+		if i != a && i != b {
+			shape = append(shape, t.shape[i])
+			stride = append(stride, t.stride[i])
+		}
+	}
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  append(shape, n),
+		stride: append(stride, t.stride[a]+t.stride[b]),
+		offset: start,
+	}
+}
+
+// Diagonal returns a view over the diagonal of the Tensor's matrices
+// spanned by the two given axes, which are removed and replaced by a
+// last axis holding the diagonal. A positive offset selects a diagonal
+// above the main one, and a negative offset a diagonal below it.
+// Offsets selecting no elements fail with ErrBadShape.
+func (t Tensor[T]) Diagonal(offset, axis1, axis2 int) Tensor[T] {
+	return t.diagonalAxes("Diagonal", offset, axis1, axis2)
+}
+
+// Trace returns the sum of the elements on the main diagonal of the
+// matrices spanned by the Tensor's last two axes, for each of the
+// matrices stacked along its leading axes.
+func (t Tensor[T]) Trace() Tensor[T] {
+	return t.diagonalAxes("Trace", 0, -2, -1).reduceAxis("Trace", sumReducer[T](), false, -1)
+}
+
+// triangle returns a new Tensor holding the elements of the matrices
+// spanned by the Tensor's last two axes for which keep returns true,
+// given their row and column, and zeros elsewhere. Errors name
+// the given method.
+func (t Tensor[T]) triangle(method string, keep func(row, col int) bool) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	rank := len(t.shape)
+	_, err := normAxis(method, -2, rank)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape := slices.Clone(t.shape)
+
+	// walking these layouts yields the element's row and column
+	rowStride := slices.WithLen[int](rank)
+	rowStride[rank-2] = 1
+	colStride := slices.WithLen[int](rank)
+	colStride[rank-1] = 1
+
+	data := slices.WithLen[T](slices.Prod(shape))
+
+	handleLayouts(shape, [][]int{configStride(shape), t.stride, rowStride, colStride}, []int{0, t.offset, 0, 0}, func(pos []int) {
+		if keep(pos[2], pos[3]) {
+			data[pos[0]] = t.data[pos[1]]
+		}
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Triu returns a new Tensor holding the upper triangle of the matrices
+// spanned by the Tensor's last two axes, on and above the diagonal at the
+// given offset, with the elements below it set to zero.
+func (t Tensor[T]) Triu(offset int) Tensor[T] {
+	return t.triangle("Triu", func(row, col int) bool {
+		return col-row >= offset
+	})
+}
+
+// Tril returns a new Tensor holding the lower triangle of the matrices
+// spanned by the Tensor's last two axes, on and below the diagonal at the
+// given offset, with the elements above it set to zero.
+func (t Tensor[T]) Tril(offset int) Tensor[T] {
+	return t.triangle("Tril", func(row, col int) bool {
+		return col-row <= offset
+	})
+}
+
+// DiagEmbed returns a new Tensor of square matrices, spanned by its last
+// two axes, whose diagonal at the given offset holds the entries of the
+// Tensor's last axis, with zeros elsewhere. The Tensor's leading axes
+// are kept as the leading axes of the result.
+func (t Tensor[T]) DiagEmbed(offset int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	rank := len(t.shape)
+	_, err := normAxis("DiagEmbed", -1, rank)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	n := t.shape[rank-1] + offset
+	if offset < 0 {
+		n -= 2 * offset
+	}
+
+	shape := make([]int, rank+1)
+	copy(shape, t.shape[:rank-1])
+	shape[rank-1], shape[rank] = n, n
+
+	out := Tensor[T]{
+		data:   slices.WithLen[T](slices.Prod(shape)),
+		shape:  shape,
+		stride: configStride(shape),
+	}
+
+	out.Diagonal(offset, -2, -1).Assign(t)
+
+	return out
+}
@@ -0,0 +1,113 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestDiagonal(t *testing.T) {
+	tensor := nune.Range[int](0, 12, 1).Reshape(3, 4)
+
+	if !slices.Equal(elements(tensor.Diagonal(0, 0, 1)), []int{0, 5, 10}) {
+		t.Error("main diagonal is incorrect")
+	}
+
+	if !slices.Equal(elements(tensor.Diagonal(2, 0, 1)), []int{2, 7}) {
+		t.Error("upper diagonal is incorrect")
+	}
+
+	if !slices.Equal(elements(tensor.Diagonal(-1, 0, 1)), []int{4, 9}) {
+		t.Error("lower diagonal is incorrect")
+	}
+
+	if !slices.Equal(elements(tensor.Diagonal(0, 1, 0)), []int{0, 5, 10}) {
+		t.Error("diagonal of swapped axes is incorrect")
+	}
+
+	if !errors.Is(tensor.Diagonal(4, 0, 1).Err, nune.ErrBadShape) || !errors.Is(tensor.Diagonal(-3, 0, 1).Err, nune.ErrBadShape) {
+		t.Error("diagonal out of the matrix selected no elements")
+	}
+
+	batch := nune.Range[int](0, 18, 1).Reshape(2, 3, 3)
+	diagonal := batch.Diagonal(0, -2, -1)
+	if !slices.Equal(diagonal.Shape(), []int{2, 3}) || !slices.Equal(elements(diagonal), []int{0, 4, 8, 9, 13, 17}) {
+		t.Error("diagonal of a batch is incorrect")
+	}
+
+	diagonal.Assign(-1)
+	if batch.Index(1, 2, 2).Scalar() != -1 {
+		t.Error("diagonal is not a view")
+	}
+
+	if tensor.Diagonal(0, 0, 0).Err == nil {
+		t.Error("diagonal of a repeated axis")
+	}
+}
+
+func TestTrace(t *testing.T) {
+	batch := nune.Range[int](0, 18, 1).Reshape(2, 3, 3)
+
+	if !slices.Equal(batch.Trace().Ravel(), []int{12, 39}) {
+		t.Error("trace of a batch is incorrect")
+	}
+
+	if nune.Range[int](0, 3, 1).Trace().Err == nil {
+		t.Error("trace of a vector")
+	}
+}
+
+func TestTriangle(t *testing.T) {
+	tensor := nune.Range[int](1, 10, 1).Reshape(3, 3)
+
+	if !slices.Equal(tensor.Triu(0).Ravel(), []int{1, 2, 3, 0, 5, 6, 0, 0, 9}) {
+		t.Error("upper triangle is incorrect")
+	}
+
+	if !slices.Equal(tensor.Triu(1).Ravel(), []int{0, 2, 3, 0, 0, 6, 0, 0, 0}) {
+		t.Error("upper triangle above the diagonal is incorrect")
+	}
+
+	if !slices.Equal(tensor.Tril(-1).Ravel(), []int{0, 0, 0, 4, 0, 0, 7, 8, 0}) {
+		t.Error("lower triangle below the diagonal is incorrect")
+	}
+
+	if !slices.Equal(tensor.T().Tril(0).Ravel(), []int{1, 0, 0, 2, 5, 0, 3, 6, 9}) {
+		t.Error("lower triangle of a view is incorrect")
+	}
+
+	batch := nune.Ones[int](2, 2, 2).Tril(0)
+	if !slices.Equal(batch.Ravel(), []int{1, 0, 1, 1, 1, 0, 1, 1}) {
+		t.Error("lower triangle of a batch is incorrect")
+	}
+}
+
+func TestDiagEmbed(t *testing.T) {
+	vectors := nune.Range[int](1, 5, 1).Reshape(2, 2)
+
+	embedded := vectors.DiagEmbed(0)
+	if !slices.Equal(embedded.Shape(), []int{2, 2, 2}) || !slices.Equal(embedded.Ravel(), []int{1, 0, 0, 2, 3, 0, 0, 4}) {
+		t.Error("diagonal embedding of a batch is incorrect")
+	}
+
+	embedded = vectors.Index(0).DiagEmbed(-1)
+	if !slices.Equal(embedded.Shape(), []int{3, 3}) || !slices.Equal(embedded.Ravel(), []int{0, 0, 0, 1, 0, 0, 0, 2, 0}) {
+		t.Error("diagonal embedding below the diagonal is incorrect")
+	}
+
+	if !slices.Equal(elements(embedded.Diagonal(-1, 0, 1)), []int{1, 2}) {
+		t.Error("diagonal embedding is not the inverse of diagonal")
+	}
+}
+
+func BenchmarkTriu(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Reshape(-1, 1000).Triu(0)
+	})
+}
@@ -7,4 +7,7 @@
 // and implements a numeric n-dimensional generic Tensor,
 // along with a set of functions to create, manipulate
 // and operate on that Tensor.
+//
+// Every method that takes an axis also accepts a negative axis,
+// which counts from the last axis, so that -1 is the last axis.
 package nune
@@ -0,0 +1,368 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vorduin/slices"
+)
+
+// ellipsisLabel is the first of the private labels given
+// to the axes covered by an ellipsis in einsum subscripts.
+const ellipsisLabel = '\uE000'
+
+// An einsumTerm is a view over an einsum operand,
+// with a distinct label for each of its axes.
+type einsumTerm[T Number] struct {
+	t      Tensor[T]
+	labels []rune
+}
+
+// parseSubscripts parses a single operand's einsum subscripts
+// into their labels, with the given number of axes covered by
+// the ellipsis if there is one, labeled so that they are right-aligned
+// with the ellipsis axes of all other operands.
+func parseSubscripts(s string, rank, ellipsisRank int) ([]rune, bool) {
+	var labels []rune
+	ellipsis := false
+
+	for i := 0; i < len(s); i++ {
+		c := rune(s[i])
+
+		switch {
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			labels = append(labels, c)
+		case strings.HasPrefix(s[i:], "...") && !ellipsis:
+			ellipsis = true
+			n := rank - (len(s) - 3) // axes covered by the ellipsis
+			if n < 0 || n > ellipsisRank {
+				return nil, false
+			}
+			for j := ellipsisRank - n; j < ellipsisRank; j++ {
+				labels = append(labels, ellipsisLabel+rune(j))
+			}
+			i += 2
+		default:
+			return nil, false
+		}
+	}
+
+	return labels, true
+}
+
+// diagonal returns a view over the Tensor with a single axis for each
+// distinct label, repeated labels taking the diagonal of their axes.
+func (t Tensor[T]) diagonal(labels []rune) (einsumTerm[T], bool) {
+	term := einsumTerm[T]{
+		t: Tensor[T]{
+			data:   t.data,
+			offset: t.offset,
+		},
+	}
+
+	for i, l := range labels {
+		j := slices.Index(term.labels, l)
+		if j < 0 {
+			term.labels = append(term.labels, l)
+			term.t.shape = append(term.t.shape, t.shape[i])
+			term.t.stride = append(term.t.stride, t.stride[i])
+		} else if term.t.shape[j] != t.shape[i] {
+			return term, false
+		} else {
+			term.t.stride[j] += t.stride[i]
+		}
+	}
+
+	return term, true
+}
+
+// view returns a view over the term's Tensor with its axes
+// ordered by the given labels, each of which must be present.
+func (term einsumTerm[T]) view(labels []rune) Tensor[T] {
+	t := Tensor[T]{
+		data:   term.t.data,
+		shape:  make([]int, len(labels)),
+		stride: make([]int, len(labels)),
+		offset: term.t.offset,
+	}
+
+	for i, l := range labels {
+		j := slices.Index(term.labels, l)
+		t.shape[i] = term.t.shape[j]
+		t.stride[i] = term.t.stride[j]
+	}
+
+	return t
+}
+
+// sumOut sums the term over all of its labels that aren't kept.
+func (term einsumTerm[T]) sumOut(keep map[rune]bool) einsumTerm[T] {
+	var axes []int
+	var labels []rune
+
+	for i, l := range term.labels {
+		if keep[l] {
+			labels = append(labels, l)
+		} else {
+			axes = append(axes, i)
+		}
+	}
+
+	if len(axes) == 0 {
+		return term
+	}
+
+	return einsumTerm[T]{
+		t:      term.t.SumAxis(false, axes...),
+		labels: labels,
+	}
+}
+
+// contract contracts two terms together, summing over their shared labels
+// that aren't kept, using a batched matrix multiplication.
+func contract[T Number](lhs, rhs einsumTerm[T], keep map[rune]bool, sizes map[rune]int) einsumTerm[T] {
+	var batch, contracted, lhsOnly, rhsOnly []rune
+
+	for _, l := range lhs.labels {
+		if slices.Index(rhs.labels, l) >= 0 {
+			if keep[l] {
+				batch = append(batch, l)
+			} else {
+				contracted = append(contracted, l)
+			}
+		} else {
+			lhsOnly = append(lhsOnly, l)
+		}
+	}
+	for _, l := range rhs.labels {
+		if slices.Index(lhs.labels, l) < 0 {
+			rhsOnly = append(rhsOnly, l)
+		}
+	}
+
+	size := func(labels []rune) int {
+		n := 1
+		for _, l := range labels {
+			n *= sizes[l]
+		}
+		return n
+	}
+
+	b, m, k, n := size(batch), size(lhsOnly), size(contracted), size(rhsOnly)
+
+	a := lhs.view(append(append(slices.Clone(batch), lhsOnly...), contracted...)).materialize(b, m, k)
+	c := rhs.view(append(append(slices.Clone(batch), contracted...), rhsOnly...)).materialize(b, k, n)
+
+	labels := append(append(slices.Clone(batch), lhsOnly...), rhsOnly...)
+	shape := make([]int, len(labels))
+	for i, l := range labels {
+		shape[i] = sizes[l]
+	}
+
+	res := a.MatMul(c)
+
+	return einsumTerm[T]{
+		t: Tensor[T]{
+			data:   res.data,
+			shape:  shape,
+			stride: configStride(shape),
+		},
+		labels: labels,
+	}
+}
+
+// keptLabels returns the labels that must be kept when contracting
+// the terms i and j together, being the output's labels along with
+// the labels of all other terms.
+func keptLabels[T Number](terms []einsumTerm[T], output []rune, i, j int) map[rune]bool {
+	keep := make(map[rune]bool)
+	for _, l := range output {
+		keep[l] = true
+	}
+
+	for k, term := range terms {
+		if k != i && k != j {
+			for _, l := range term.labels {
+				keep[l] = true
+			}
+		}
+	}
+
+	return keep
+}
+
+// Einsum evaluates the Einstein summation convention on the operands,
+// as described by the given subscripts, such as "bij,bjk->bik" for a
+// batched matrix multiplication. Labels repeated within an operand take
+// its diagonal, and labels missing from the output are summed over.
+// If the output is omitted, it is made of the labels appearing only once,
+// in alphabetical order. An ellipsis stands for all axes not otherwise
+// labeled, which are broadcast together across operands.
+// With three or more operands, the operands are contracted pairwise,
+// in an order that greedily minimizes the size of the intermediate results.
+func Einsum[T Number](spec string, operands ...Tensor[T]) Tensor[T] {
+	for _, t := range operands {
+		if t.Err != nil {
+			if EnvConfig.Interactive {
+				panic(t.Err)
+			} else {
+				return Tensor[T]{
+					Err: t.Err,
+				}
+			}
+		}
+	}
+
+	term, ok := handleEinsum(strings.ReplaceAll(spec, " ", ""), operands)
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrBadSubscripts)
+		} else {
+			return Tensor[T]{
+				Err: ErrBadSubscripts,
+			}
+		}
+	}
+
+	return term.t
+}
+
+// handleEinsum processes an einsum evaluation, and returns its result
+// along with whether or not the subscripts are valid for the operands.
+func handleEinsum[T Number](spec string, operands []Tensor[T]) (einsumTerm[T], bool) {
+	inputs, output, explicit := strings.Cut(spec, "->")
+
+	subscripts := strings.Split(inputs, ",")
+	if len(operands) == 0 || len(subscripts) != len(operands) {
+		return einsumTerm[T]{}, false
+	}
+
+	ellipsisRank := 0
+	for i, s := range subscripts {
+		if strings.Contains(s, "...") {
+			if n := operands[i].Rank() - (len(s) - 3); n > ellipsisRank {
+				ellipsisRank = n
+			}
+		}
+	}
+
+	terms := make([]einsumTerm[T], len(operands))
+	sizes := make(map[rune]int)
+	counts := make(map[rune]int)
+
+	for i, s := range subscripts {
+		labels, ok := parseSubscripts(s, operands[i].Rank(), ellipsisRank)
+		if !ok || len(labels) != operands[i].Rank() {
+			return einsumTerm[T]{}, false
+		}
+
+		terms[i], ok = operands[i].diagonal(labels)
+		if !ok {
+			return einsumTerm[T]{}, false
+		}
+
+		for _, l := range labels {
+			counts[l]++
+		}
+
+		for j, l := range terms[i].labels {
+			size := terms[i].t.shape[j]
+			if sizes[l] == 0 || sizes[l] == 1 && l >= ellipsisLabel {
+				sizes[l] = size
+			} else if size != sizes[l] && !(size == 1 && l >= ellipsisLabel) {
+				return einsumTerm[T]{}, false
+			}
+		}
+	}
+
+	// broadcast the ellipsis axes with a null stride
+	for _, term := range terms {
+		for j, l := range term.labels {
+			if term.t.shape[j] != sizes[l] {
+				term.t.shape[j] = sizes[l]
+				term.t.stride[j] = 0
+			}
+		}
+	}
+
+	var labels []rune
+	if explicit {
+		var ok bool
+		labels, ok = parseSubscripts(output, len(output)-3+ellipsisRank, ellipsisRank)
+		if !ok {
+			return einsumTerm[T]{}, false
+		}
+	} else {
+		for j := 0; j < ellipsisRank; j++ {
+			labels = append(labels, ellipsisLabel+rune(j))
+		}
+		for l, n := range counts {
+			if n == 1 && l < ellipsisLabel {
+				labels = append(labels, l)
+			}
+		}
+		sort.Slice(labels[ellipsisRank:], func(i, j int) bool {
+			return labels[ellipsisRank+i] < labels[ellipsisRank+j]
+		})
+	}
+
+	for i, l := range labels {
+		if sizes[l] == 0 || slices.Index(labels[:i], l) >= 0 {
+			return einsumTerm[T]{}, false
+		}
+	}
+
+	// sum over the labels that appear in a single operand only
+	for i := range terms {
+		terms[i] = terms[i].sumOut(keptLabels(terms, labels, i, i))
+	}
+
+	for len(terms) > 1 {
+		// greedily contract the pair of terms with the smallest result
+		bi, bj, best := 0, 1, -1
+		for i := 0; i < len(terms); i++ {
+			for j := i + 1; j < len(terms); j++ {
+				keep := keptLabels(terms, labels, i, j)
+
+				size := 1
+				seen := make(map[rune]bool)
+				for _, l := range append(slices.Clone(terms[i].labels), terms[j].labels...) {
+					if keep[l] && !seen[l] {
+						size *= sizes[l]
+						seen[l] = true
+					}
+				}
+
+				if best < 0 || size < best {
+					bi, bj, best = i, j, size
+				}
+			}
+		}
+
+		keep := keptLabels(terms, labels, bi, bj)
+
+		// sum over the labels that are neither kept
+		// nor shared with the other term beforehand
+		lhsKeep := keptLabels(terms, labels, bi, bi)
+		rhsKeep := keptLabels(terms, labels, bj, bj)
+		lhs := terms[bi].sumOut(lhsKeep)
+		rhs := terms[bj].sumOut(rhsKeep)
+
+		terms[bi] = contract(lhs, rhs, keep, sizes)
+		terms = append(terms[:bj], terms[bj+1:]...)
+	}
+
+	shape := make([]int, len(labels))
+	for i, l := range labels {
+		shape[i] = sizes[l]
+	}
+
+	return einsumTerm[T]{
+		t:      terms[0].sumOut(keptLabels[T](nil, labels, 0, 0)).view(labels).materialize(shape...),
+		labels: labels,
+	}, true
+}
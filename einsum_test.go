@@ -0,0 +1,81 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestEinsum(t *testing.T) {
+	a := nune.Range[int](0, 6, 1).Reshape(2, 3)
+	b := nune.Range[int](0, 12, 1).Reshape(3, 4)
+	sq := nune.Range[int](0, 9, 1).Reshape(3, 3)
+
+	tests := []struct {
+		spec     string
+		operands []nune.Tensor[int]
+		expected nune.Tensor[int]
+	}{
+		{"ij,jk->ik", []nune.Tensor[int]{a, b}, a.MatMul(b)},
+		{"ij,jk", []nune.Tensor[int]{a, b}, a.MatMul(b)},
+		{"ij->ji", []nune.Tensor[int]{a}, nune.From[int]([][]int{{0, 3}, {1, 4}, {2, 5}})},
+		{"ii", []nune.Tensor[int]{sq}, nune.From[int](12)},
+		{"ii->i", []nune.Tensor[int]{sq}, nune.From[int]([]int{0, 4, 8})},
+		{"ij->", []nune.Tensor[int]{a}, nune.From[int](15)},
+		{"i,i", []nune.Tensor[int]{sq.Index(1), sq.Index(2)}, nune.From[int](3*6 + 4*7 + 5*8)},
+		{"...j,j->...", []nune.Tensor[int]{a, nune.Ones[int](3)}, nune.From[int]([]int{3, 12})},
+		{"ij,jk,kl->il", []nune.Tensor[int]{a, b, nune.Ones[int](4, 2)}, a.MatMul(b).MatMul(nune.Ones[int](4, 2))},
+	}
+
+	for _, test := range tests {
+		res := nune.Einsum(test.spec, test.operands...)
+		if res.Err != nil {
+			t.Errorf("einsum %q failed: %v", test.spec, res.Err)
+			continue
+		}
+
+		if !slices.Equal(res.Shape(), test.expected.Shape()) || !slices.Equal(res.Ravel(), test.expected.Ravel()) {
+			t.Errorf("einsum %q returned %v instead of %v", test.spec, res, test.expected)
+		}
+	}
+}
+
+func TestEinsumBatched(t *testing.T) {
+	a := nune.Range[float64](0, 24, 1).Reshape(2, 3, 4)
+	b := nune.Range[float64](0, 40, 1).Reshape(2, 4, 5)
+
+	res := nune.Einsum("bij,bjk->bik", a, b)
+	if !slices.Equal(res.Ravel(), a.MatMul(b).Ravel()) {
+		t.Error("batched matrix product is incorrect")
+	}
+
+	// the ellipsis broadcasts against a rank 1 operand
+	res = nune.Einsum("...ij,...j->...i", a, nune.Ones[float64](4))
+	if !slices.Equal(res.Ravel(), a.SumAxis(false, 2).Ravel()) {
+		t.Error("ellipsis broadcasting is incorrect")
+	}
+
+	res = nune.Einsum("...ij,...jk->...ik", a, b.Index(1).Reshape(1, 4, 5))
+	if !slices.Equal(res.Ravel(), a.MatMul(b.Index(1)).Ravel()) {
+		t.Error("ellipsis broadcasting of a unit axis is incorrect")
+	}
+}
+
+func TestEinsumBadSubscripts(t *testing.T) {
+	a := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	for _, spec := range []string{"ij,jk->ik", "ijk", "ij->ik", "ij->ii", "i1", "ii"} {
+		if nune.Einsum(spec, a).Err == nil {
+			t.Errorf("einsum accepted the bad subscripts %q", spec)
+		}
+	}
+
+	if nune.Einsum("ij,ij", a, nune.Zeros[int](3, 2)).Err == nil {
+		t.Error("einsum accepted mismatching dimensions")
+	}
+}
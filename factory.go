@@ -157,8 +157,9 @@ func Range[T Number](start, end, step int) Tensor[T] {
 		}
 	}
 
-	d := math.Sqrt(math.Pow(float64(end-start), 2))   // distance
-	l := int(math.Floor(d / math.Abs(float64(step)))) // length
+	d := int(math.Abs(float64(end - start))) // distance
+	s := int(math.Abs(float64(step)))        // step size
+	l := (d + s - 1) / s                     // length, counting a last partial step
 
 	i := 0
 	rng := slices.WithLen[T](l)
@@ -174,6 +175,251 @@ func Range[T Number](start, end, step int) Tensor[T] {
 	}
 }
 
+// Arange returns a rank 1 Tensor on the interval [start, end), and
+// with the given step-size, which might be fractional. Each element is
+// computed as start + i*step, so that rounding errors don't accumulate.
+// It fails with ErrBadStep if the interval is empty, if the step doesn't
+// match its order, or if any argument isn't finite.
+func Arange[T Number](start, end, step float64) Tensor[T] {
+	err := verifyGoodFloatStep(step, start, end)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[T]{
+				Err: err,
+			}
+		}
+	}
+
+	l := int(math.Ceil((end - start) / step))
+
+	rng := slices.WithLen[T](l)
+	for i := range rng {
+		rng[i] = T(start + float64(i)*step)
+	}
+
+	return Tensor[T]{
+		data:   rng,
+		shape:  []int{len(rng)},
+		stride: configStride([]int{len(rng)}),
+	}
+}
+
+// Linspace returns a rank 1 Tensor of n evenly spaced elements on the
+// interval [start, stop], or on [start, stop) if endpoint is false.
+func Linspace[T Number](start, stop float64, n int, endpoint bool) Tensor[T] {
+	err := verifyGoodShape(n)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[T]{
+				Err: err,
+			}
+		}
+	}
+
+	div := n
+	if endpoint {
+		div = n - 1
+	}
+
+	step := 0.0
+	if div > 0 {
+		step = (stop - start) / float64(div)
+	}
+
+	rng := slices.WithLen[T](n)
+	for i := range rng {
+		rng[i] = T(start + float64(i)*step)
+	}
+
+	if endpoint && n > 1 {
+		rng[n-1] = T(stop)
+	}
+
+	return Tensor[T]{
+		data:   rng,
+		shape:  []int{n},
+		stride: configStride([]int{n}),
+	}
+}
+
+// Logspace returns a rank 1 Tensor of n elements evenly spaced on a log
+// scale, from base^start to base^stop, inclusive if endpoint is true.
+func Logspace[T Number](start, stop float64, n int, endpoint bool, base float64) Tensor[T] {
+	exps := Linspace[float64](start, stop, n, endpoint)
+	if exps.Err != nil {
+		return Tensor[T]{
+			Err: exps.Err,
+		}
+	}
+
+	rng := slices.WithLen[T](n)
+	for i, e := range exps.data {
+		rng[i] = T(math.Pow(base, e))
+	}
+
+	return Tensor[T]{
+		data:   rng,
+		shape:  []int{n},
+		stride: configStride([]int{n}),
+	}
+}
+
+// Eye returns an n by m matrix with ones on its diagonal at
+// offset k, and zeros elsewhere. A positive k selects a diagonal
+// above the main one, and a negative k a diagonal below it.
+func Eye[T Number](n, m, k int) Tensor[T] {
+	t := Zeros[T](n, m)
+	if t.Err != nil {
+		return t
+	}
+
+	for i := 0; i < n; i++ {
+		if j := i + k; j >= 0 && j < m {
+			t.data[i*m+j] = 1
+		}
+	}
+
+	return t
+}
+
+// Identity returns the n by n identity matrix.
+func Identity[T Number](n int) Tensor[T] {
+	return Eye[T](n, n, 0)
+}
+
+// Diag returns a new square matrix whose diagonal at offset k holds the
+// elements of the given rank 1 Tensor, or a new rank 1 Tensor holding
+// the elements on the diagonal at offset k of the given matrix.
+func Diag[T Number](t Tensor[T], k int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	switch len(t.shape) {
+	case 1:
+		return t.DiagEmbed(k)
+	case 2:
+		diagonal := t.diagonalAxes("Diag", k, 0, 1)
+		if diagonal.Err != nil {
+			return diagonal
+		}
+
+		return diagonal.materialize(diagonal.shape...)
+	default:
+		if EnvConfig.Interactive {
+			panic(ErrBadShape)
+		} else {
+			return Tensor[T]{
+				Err: ErrBadShape,
+			}
+		}
+	}
+}
+
+// Indexing is the order of the axes of the grids built by Meshgrid.
+type Indexing int
+
+// List of indexings, for grids built from vectors of lengths n1, n2, ...
+const (
+	IndexingXY Indexing = iota // cartesian, with grids of shape (n2, n1, ...)
+	IndexingIJ                 // matrix, with grids of shape (n1, n2, ...)
+)
+
+// Meshgrid returns one grid per given rank 1 Tensor, each holding
+// the Tensor's elements repeated along the grid's other axes. The grids
+// are broadcast views over the Tensors, which must be cloned to be
+// written to. Unknown indexings fail with ErrBadIndexing.
+func Meshgrid[T Number](indexing Indexing, ts ...Tensor[T]) []Tensor[T] {
+	if len(ts) == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrNoOperands)
+		} else {
+			return []Tensor[T]{{
+				Err: ErrNoOperands,
+			}}
+		}
+	}
+
+	if indexing != IndexingXY && indexing != IndexingIJ {
+		if EnvConfig.Interactive {
+			panic(ErrBadIndexing)
+		} else {
+			return []Tensor[T]{{
+				Err: ErrBadIndexing,
+			}}
+		}
+	}
+
+	shape := make([]int, len(ts))
+	for i, t := range ts {
+		err := t.Err
+		if err == nil && len(t.shape) != 1 {
+			err = &OperandError{
+				Method:  "Meshgrid",
+				Operand: i,
+				Err:     ErrBadShape,
+			}
+		}
+
+		if err != nil {
+			if EnvConfig.Interactive {
+				panic(err)
+			} else {
+				return []Tensor[T]{{
+					Err: err,
+				}}
+			}
+		}
+
+		shape[i] = t.shape[0]
+	}
+
+	// the axes of the first two Tensors are swapped in cartesian indexing
+	axes := make([]int, len(ts))
+	for i := range axes {
+		axes[i] = i
+	}
+	if indexing == IndexingXY && len(ts) > 1 {
+		axes[0], axes[1] = 1, 0
+		shape[0], shape[1] = shape[1], shape[0]
+	}
+
+	grids := make([]Tensor[T], len(ts))
+	for i, t := range ts {
+		stride := make([]int, len(ts))
+		stride[axes[i]] = t.stride[0]
+
+		grids[i] = Tensor[T]{
+			data:   t.data,
+			shape:  slices.Clone(shape),
+			stride: stride,
+			offset: t.offset,
+		}
+	}
+
+	return grids
+}
+
+// Empty returns a Tensor satisfying the given shape, whose
+// elements are meant to be overwritten before being read.
+func Empty[T Number](shape ...int) Tensor[T] {
+	return Zeros[T](shape...)
+}
+
+// EmptyLike returns a Tensor resembling the other Tensor's shape, whose
+// elements are meant to be overwritten before being read.
+func EmptyLike[T Number, U Number](other Tensor[U]) Tensor[T] {
+	return ZerosLike[T](other)
+}
+
 // FromBuffer returns a Tensor with the given buffer set as its data buffer.
 func FromBuffer[T Number](buf []T) Tensor[T] {
 	err := verifyGoodShape(len(buf))
@@ -5,6 +5,7 @@
 package nune_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/vorduin/nune"
@@ -175,6 +176,141 @@ func TestOnesLike(t *testing.T) {
 	}
 }
 
+func TestRange(t *testing.T) {
+	if !slices.Equal(nune.Range[int](0, 10, 3).Ravel(), []int{0, 3, 6, 9}) {
+		t.Error("range dropped its last partial step")
+	}
+
+	if !slices.Equal(nune.Range[int](5, -1, -2).Ravel(), []int{5, 3, 1}) {
+		t.Error("descending range is incorrect")
+	}
+
+	if nune.Range[int](0, 10, -1).Err == nil {
+		t.Error("range was initialized with a step opposite to its interval")
+	}
+}
+
+func TestArange(t *testing.T) {
+	if !slices.Equal(nune.Arange[float64](0, 1, 0.25).Ravel(), []float64{0, 0.25, 0.5, 0.75}) {
+		t.Error("arange of a fractional step is incorrect")
+	}
+
+	if !slices.Equal(nune.Arange[float64](1, 0.1, -0.25).Ravel(), []float64{1, 0.75, 0.5, 0.25}) {
+		t.Error("descending arange is incorrect")
+	}
+
+	if nune.Arange[float64](0, 1, 0).Err == nil {
+		t.Error("arange was initialized with a null step")
+	}
+
+	if nune.Arange[float64](1, 1, 1).Err == nil {
+		t.Error("arange was initialized with an empty interval")
+	}
+
+	if nune.Arange[float64](0, math.Inf(1), 1).Err == nil || nune.Arange[float64](0, 1, math.NaN()).Err == nil {
+		t.Error("arange was initialized with a non-finite argument")
+	}
+}
+
+func TestLinspace(t *testing.T) {
+	if !slices.Equal(nune.Linspace[float64](0, 1, 5, true).Ravel(), []float64{0, 0.25, 0.5, 0.75, 1}) {
+		t.Error("linspace including its endpoint is incorrect")
+	}
+
+	if !slices.Equal(nune.Linspace[float64](0, 1, 4, false).Ravel(), []float64{0, 0.25, 0.5, 0.75}) {
+		t.Error("linspace excluding its endpoint is incorrect")
+	}
+
+	if !slices.Equal(nune.Linspace[float64](2, 3, 1, true).Ravel(), []float64{2}) {
+		t.Error("linspace of a single element is incorrect")
+	}
+
+	if nune.Linspace[float64](0, 1, 0, true).Err == nil {
+		t.Error("linspace was initialized with no elements")
+	}
+
+	if !slices.Equal(nune.Logspace[float64](0, 3, 4, true, 10).Ravel(), []float64{1, 10, 100, 1000}) {
+		t.Error("logspace is incorrect")
+	}
+}
+
+func TestEye(t *testing.T) {
+	if !slices.Equal(nune.Eye[int](2, 3, 1).Ravel(), []int{0, 1, 0, 0, 0, 1}) {
+		t.Error("eye above the diagonal is incorrect")
+	}
+
+	if !slices.Equal(nune.Identity[int](2).Ravel(), []int{1, 0, 0, 1}) {
+		t.Error("identity is incorrect")
+	}
+
+	if nune.Eye[int](0, 2, 0).Err == nil {
+		t.Error("eye was initialized with a null axis")
+	}
+}
+
+func TestDiag(t *testing.T) {
+	matrix := nune.Diag(nune.Range[int](1, 3, 1), 0)
+	if !slices.Equal(matrix.Ravel(), []int{1, 0, 0, 2}) {
+		t.Error("diagonal matrix is incorrect")
+	}
+
+	vector := nune.Diag(nune.Range[int](0, 6, 1).Reshape(2, 3), 1)
+	if !slices.Equal(vector.Ravel(), []int{1, 5}) || !vector.IsContiguous() {
+		t.Error("diagonal of a matrix is incorrect")
+	}
+
+	if nune.Diag(nune.Range[int](0, 6, 1).Reshape(2, 3), 7).Err == nil {
+		t.Error("diagonal out of the matrix selected no elements")
+	}
+
+	if nune.Diag(nune.Zeros[int](2, 2, 2), 0).Err == nil {
+		t.Error("diagonal of a rank 3 tensor")
+	}
+}
+
+func TestMeshgrid(t *testing.T) {
+	x, y := nune.Range[int](0, 3, 1), nune.Range[int](0, 2, 1)
+
+	grids := nune.Meshgrid(nune.IndexingXY, x, y)
+	if !slices.Equal(grids[0].Shape(), []int{2, 3}) || !slices.Equal(elements(grids[0]), []int{0, 1, 2, 0, 1, 2}) {
+		t.Error("cartesian x grid is incorrect")
+	}
+
+	if !slices.Equal(elements(grids[1]), []int{0, 0, 0, 1, 1, 1}) {
+		t.Error("cartesian y grid is incorrect")
+	}
+
+	grids = nune.Meshgrid(nune.IndexingIJ, x, y)
+	if !slices.Equal(grids[0].Shape(), []int{3, 2}) || !slices.Equal(elements(grids[1]), []int{0, 1, 0, 1, 0, 1}) {
+		t.Error("matrix grids are incorrect")
+	}
+
+	grids = nune.Meshgrid(nune.Indexing(2), x, y)
+	if len(grids) != 1 || grids[0].Err == nil {
+		t.Error("meshgrid of an unknown indexing")
+	}
+
+	grids = nune.Meshgrid(nune.IndexingIJ, x, nune.Zeros[int](2, 2))
+	if len(grids) != 1 || grids[0].Err == nil {
+		t.Error("meshgrid of a matrix")
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	tensor := nune.Empty[int](2, 3)
+	if !slices.Equal(tensor.Shape(), []int{2, 3}) {
+		t.Error("tensor was not initialized with the given shape")
+	}
+
+	if !slices.Equal(nune.EmptyLike[float64](tensor).Shape(), []int{2, 3}) {
+		t.Error("like was not initialized with the same shape as tensor")
+	}
+
+	if nune.Empty[int](0).Err == nil {
+		t.Error("tensor was initialized with a null axis")
+	}
+}
+
 func TestFromBuffer(t *testing.T) {
 	buf := []int{1, 2, 3, 4}
 	tensor := nune.FromBuffer(buf)
@@ -0,0 +1,333 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// verifyIndexShape makes sure the index Tensor has the same rank
+// as the Tensor, and that its dimensions don't exceed the Tensor's
+// dimensions on any axis but the given one.
+func verifyIndexShape(shape, idxShape []int, axis int) error {
+	if len(shape) != len(idxShape) {
+		return ErrShapeMismatch
+	}
+
+	for i := range shape {
+		if i != axis && idxShape[i] > shape[i] {
+			return ErrShapeMismatch
+		}
+	}
+
+	return nil
+}
+
+// selectEntries returns a new Tensor made of the Tensor's entries
+// along the given axis at the given indices, which must lie
+// within the axis's bounds.
+func (t Tensor[T]) selectEntries(axis int, entries []int) Tensor[T] {
+	shape := slices.Clone(t.shape)
+	shape[axis] = len(entries)
+
+	srcStride := slices.Clone(t.stride)
+	srcStride[axis] = 0
+	idxStride := slices.WithLen[int](len(shape))
+	idxStride[axis] = 1
+
+	data := slices.WithLen[T](slices.Prod(shape))
+	step := t.stride[axis]
+
+	handleLayouts(shape, [][]int{configStride(shape), srcStride, idxStride}, []int{0, t.offset, 0}, func(pos []int) {
+		data[pos[0]] = t.data[pos[1]+entries[pos[2]]*step]
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// IndexSelect returns a new Tensor made of the Tensor's entries
+// along the given axis at the indices held by the rank 1 index Tensor.
+func (t Tensor[T]) IndexSelect(axis int, idx Tensor[int]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if idx.Err != nil {
+		if EnvConfig.Interactive {
+			panic(idx.Err)
+		} else {
+			t.Err = idx.Err
+			return t
+		}
+	}
+
+	axis, err := normAxis("IndexSelect", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	if idx.Rank() != 1 {
+		if EnvConfig.Interactive {
+			panic(ErrBadShape)
+		} else {
+			t.Err = ErrBadShape
+			return t
+		}
+	}
+
+	err = verifyIndices(idx, axis, t.shape[axis])
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	return t.selectEntries(axis, idx.Ravel())
+}
+
+// Gather returns a new Tensor with the index Tensor's shape, made of the
+// Tensor's elements along the given axis at the indices held by the index
+// Tensor, which must be of the same rank as the Tensor. For a rank 3 Tensor
+// and an axis of 1, the result is:
+//
+//	out[i][j][k] = t[i][idx[i][j][k]][k]
+func (t Tensor[T]) Gather(axis int, idx Tensor[int]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if idx.Err != nil {
+		if EnvConfig.Interactive {
+			panic(idx.Err)
+		} else {
+			t.Err = idx.Err
+			return t
+		}
+	}
+
+	axis, err := normAxis("Gather", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	err = verifyIndexShape(t.shape, idx.shape, axis)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	err = verifyIndices(idx, axis, t.shape[axis])
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape := slices.Clone(idx.shape)
+
+	srcStride := slices.Clone(t.stride)
+	srcStride[axis] = 0
+
+	data := slices.WithLen[T](idx.Numel())
+	step := t.stride[axis]
+
+	handleLayouts(shape, [][]int{configStride(shape), srcStride, idx.stride}, []int{0, t.offset, idx.offset}, func(pos []int) {
+		data[pos[0]] = t.data[pos[1]+idx.data[pos[2]]*step]
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Take returns a new Tensor with the index Tensor's shape, made of
+// the Tensor's elements at the indices held by the index Tensor,
+// as if the Tensor were flattened in row-major order.
+func (t Tensor[T]) Take(idx Tensor[int]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if idx.Err != nil {
+		if EnvConfig.Interactive {
+			panic(idx.Err)
+		} else {
+			t.Err = idx.Err
+			return t
+		}
+	}
+
+	err := verifyIndices(idx, -1, t.Numel())
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape := slices.Clone(idx.shape)
+	data := slices.WithLen[T](idx.Numel())
+	contiguous := isContiguous(t.shape, t.stride)
+
+	handleLayouts(shape, [][]int{configStride(shape), idx.stride}, []int{0, idx.offset}, func(pos []int) {
+		if contiguous {
+			data[pos[0]] = t.data[t.offset+idx.data[pos[1]]]
+		} else {
+			data[pos[0]] = t.data[t.position(idx.data[pos[1]])]
+		}
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// scatter writes the source Tensor's elements into the Tensor along the
+// given axis at the indices held by the index Tensor, combining each
+// existing element with the written one using f. Errors name the given method.
+func (t Tensor[T]) scatter(method string, axis int, idx Tensor[int], src Tensor[T], f func(T, T) T) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	for _, err := range []error{idx.Err, src.Err} {
+		if err != nil {
+			if EnvConfig.Interactive {
+				panic(err)
+			} else {
+				t.Err = err
+				return t
+			}
+		}
+	}
+
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	axis, err = normAxis(method, axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	err = verifyIndexShape(t.shape, idx.shape, axis)
+	if err == nil {
+		err = verifyIndexShape(src.shape, idx.shape, -1)
+	}
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	err = verifyIndices(idx, axis, t.shape[axis])
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	dstStride := slices.Clone(t.stride)
+	dstStride[axis] = 0
+	step := t.stride[axis]
+
+	// indices might be repeated, so elements are written
+	// sequentially for the last write to deterministically win
+	walkLayouts(idx.shape, [][]int{dstStride, idx.stride, src.stride}, []int{t.offset, idx.offset, src.offset}, 0, idx.Numel(), func(pos []int) {
+		dst := pos[0] + idx.data[pos[1]]*step
+		t.data[dst] = f(t.data[dst], src.data[pos[2]])
+	})
+
+	return t
+}
+
+// Scatter writes the source Tensor's elements into the Tensor along the
+// given axis at the indices held by the index Tensor, which must be of the
+// same rank as the Tensor and the source Tensor. For a rank 3 Tensor
+// and an axis of 1, the operation is:
+//
+//	t[i][idx[i][j][k]][k] = src[i][j][k]
+//
+// If an index is repeated, the last element written prevails.
+func (t Tensor[T]) Scatter(axis int, idx Tensor[int], src Tensor[T]) Tensor[T] {
+	return t.scatter("Scatter", axis, idx, src, func(_, y T) T {
+		return y
+	})
+}
+
+// ScatterAdd adds the source Tensor's elements to the Tensor's elements
+// along the given axis at the indices held by the index Tensor, accumulating
+// all elements written to a repeated index. For a rank 3 Tensor
+// and an axis of 1, the operation is:
+//
+//	t[i][idx[i][j][k]][k] += src[i][j][k]
+func (t Tensor[T]) ScatterAdd(axis int, idx Tensor[int], src Tensor[T]) Tensor[T] {
+	return t.scatter("ScatterAdd", axis, idx, src, func(x, y T) T {
+		return x + y
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestIndexSelect(t *testing.T) {
+	tensor := nune.Range[int](0, 12, 1).Reshape(3, 4)
+	idx := nune.FromBuffer([]int{3, 0, 3})
+
+	cols := tensor.IndexSelect(1, idx)
+	if !slices.Equal(cols.Ravel(), []int{3, 0, 3, 7, 4, 7, 11, 8, 11}) {
+		t.Error("index select along axis 1 is incorrect")
+	}
+
+	if !slices.Equal(cols.Shape(), []int{3, 3}) {
+		t.Error("index select along axis 1 has the wrong shape")
+	}
+
+	// selecting from a strided view
+	rows := tensor.Permute(1, 0).IndexSelect(0, nune.FromBuffer([]int{1}))
+	if !slices.Equal(rows.Ravel(), []int{1, 5, 9}) {
+		t.Error("index select from a view is incorrect")
+	}
+}
+
+func TestGather(t *testing.T) {
+	tensor := nune.FromBuffer([]int{1, 2, 3, 4}).Reshape(2, 2)
+	idx := nune.FromBuffer([]int{0, 0, 1, 0}).Reshape(2, 2)
+
+	out := tensor.Gather(1, idx)
+	if !slices.Equal(out.Ravel(), []int{1, 1, 4, 3}) {
+		t.Error("gather along axis 1 is incorrect")
+	}
+
+	out = tensor.Gather(0, idx)
+	if !slices.Equal(out.Ravel(), []int{1, 2, 3, 2}) {
+		t.Error("gather along axis 0 is incorrect")
+	}
+
+	if tensor.Gather(0, nune.FromBuffer([]int{0, 1})).Err == nil {
+		t.Error("gathered with an index of the wrong rank")
+	}
+}
+
+func TestTake(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3).Permute(1, 0)
+	idx := nune.FromBuffer([]int{0, 1, 5, 2}).Reshape(2, 2)
+
+	out := tensor.Take(idx)
+	if !slices.Equal(out.Ravel(), []int{0, 3, 5, 1}) {
+		t.Error("take from a view is incorrect")
+	}
+
+	if !slices.Equal(out.Shape(), []int{2, 2}) {
+		t.Error("take has the wrong shape")
+	}
+}
+
+func TestTakeOutOfBounds(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1)
+
+	out := tensor.Take(nune.FromBuffer([]int{1, 6}))
+
+	var err *nune.IndexError
+	if !errors.As(out.Err, &err) {
+		t.Fatal("took an out of bounds index")
+	}
+
+	if err.Index != 6 || err.Axis != -1 || err.Size != 6 {
+		t.Error("index error does not describe the bad index")
+	}
+}
+
+func TestScatter(t *testing.T) {
+	tensor := nune.Zeros[int](3, 2)
+	idx := nune.FromBuffer([]int{2, 0, 2, 1}).Reshape(2, 2)
+	src := nune.FromBuffer([]int{1, 2, 3, 4}).Reshape(2, 2)
+
+	tensor.Scatter(0, idx, src)
+	if !slices.Equal(tensor.Ravel(), []int{0, 2, 0, 4, 3, 0}) {
+		t.Error("scatter along axis 0 is incorrect")
+	}
+
+	sums := nune.Zeros[int](3)
+	sums.ScatterAdd(0, nune.FromBuffer([]int{0, 2, 0, 0}), nune.FromBuffer([]int{1, 2, 3, 4}))
+	if !slices.Equal(sums.Ravel(), []int{8, 0, 2}) {
+		t.Error("scatter add with repeated indices is incorrect")
+	}
+
+	var err *nune.IndexError
+	if !errors.As(sums.ScatterAdd(0, nune.FromBuffer([]int{3}), nune.FromBuffer([]int{1})).Err, &err) || err.Axis != 0 {
+		t.Error("scattered to an out of bounds index")
+	}
+}
+
+func BenchmarkTake(b *testing.B) {
+	idx := nune.Range[int](0, 1e7, 1).Reverse()
+
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Take(idx)
+	})
+}
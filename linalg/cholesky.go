@@ -0,0 +1,50 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"math"
+
+	"github.com/vorduin/nune"
+)
+
+// Cholesky computes the Cholesky decomposition of the symmetric
+// positive-definite matrices held by the Tensor, returning the lower
+// triangular matrices l such that a = l l'.
+// Only the lower triangle of each matrix is read.
+func Cholesky[F Float](a nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	l := make([]float64, len(data))
+	for b := 0; b < len(data); b += n * n {
+		for j := 0; j < n; j++ {
+			d := data[b+j*n+j]
+			for k := 0; k < j; k++ {
+				d -= l[b+j*n+k] * l[b+j*n+k]
+			}
+			if !(d > 0) {
+				return failed[F](ErrNotPositiveDefinite)
+			}
+			d = math.Sqrt(d)
+			l[b+j*n+j] = d
+
+			for i := j + 1; i < n; i++ {
+				s := data[b+i*n+j]
+				for k := 0; k < j; k++ {
+					s -= l[b+i*n+k] * l[b+j*n+k]
+				}
+				l[b+i*n+j] = s / d
+			}
+		}
+	}
+
+	return pack[F](l, a.Shape()...)
+}
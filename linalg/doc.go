@@ -0,0 +1,11 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linalg provides linear algebra routines, such as
+// matrix decompositions and linear system solvers, operating
+// directly on Nune's floating-point Tensors.
+// All routines treat Tensors of rank greater than 2 as batches
+// of matrices stored in their last two axes, and report errors
+// following Nune's environment configuration.
+package linalg
@@ -0,0 +1,125 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vorduin/nune"
+)
+
+// maxSweeps is the maximum number of sweeps performed
+// by the Jacobi algorithms before giving up on convergence.
+const maxSweeps = 100
+
+// eighFactor computes the eigenvalues, in ascending order, and the
+// corresponding eigenvectors, stored as columns, of the symmetric
+// row-major n by n matrix a, using the cyclic Jacobi algorithm.
+// The matrix is overwritten.
+func eighFactor(a []float64, n int) ([]float64, []float64) {
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		var off, diag float64
+		for i := 0; i < n; i++ {
+			diag += a[i*n+i] * a[i*n+i]
+			for j := i + 1; j < n; j++ {
+				off += a[i*n+j] * a[i*n+j]
+			}
+		}
+		if off <= 1e-30*diag || off == 0 {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p*n+q] == 0 {
+					continue
+				}
+
+				theta := (a[q*n+q] - a[p*n+p]) / (2 * a[p*n+q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					kp, kq := a[k*n+p], a[k*n+q]
+					a[k*n+p] = c*kp - s*kq
+					a[k*n+q] = s*kp + c*kq
+				}
+				for k := 0; k < n; k++ {
+					pk, qk := a[p*n+k], a[q*n+k]
+					a[p*n+k] = c*pk - s*qk
+					a[q*n+k] = s*pk + c*qk
+				}
+				for k := 0; k < n; k++ {
+					kp, kq := v[k*n+p], v[k*n+q]
+					v[k*n+p] = c*kp - s*kq
+					v[k*n+q] = s*kp + c*kq
+				}
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return a[order[i]*n+order[i]] < a[order[j]*n+order[j]]
+	})
+
+	w := make([]float64, n)
+	vs := make([]float64, n*n)
+	for j, o := range order {
+		w[j] = a[o*n+o]
+		for i := 0; i < n; i++ {
+			vs[i*n+j] = v[i*n+o]
+		}
+	}
+
+	return w, vs
+}
+
+// Eigh computes the eigenvalues, in ascending order, and the eigenvectors
+// of the symmetric matrices held by the Tensor, such that a v = v diag(w),
+// where the columns of v are the orthonormal eigenvectors.
+// Only the symmetric part of each matrix is considered.
+func Eigh[F Float](a nune.Tensor[F]) (w, v nune.Tensor[F]) {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err), failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	wData := make([]float64, 0, len(data)/n)
+	vData := make([]float64, 0, len(data))
+	for b := 0; b < len(data); b += n * n {
+		m := data[b : b+n*n]
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				s := (m[i*n+j] + m[j*n+i]) / 2
+				m[i*n+j], m[j*n+i] = s, s
+			}
+		}
+
+		wb, vb := eighFactor(m, n)
+		wData = append(wData, wb...)
+		vData = append(vData, vb...)
+	}
+
+	batch := batchShape(a)
+
+	return pack[F](wData, withShape(batch, n)...), pack[F](vData, a.Shape()...)
+}
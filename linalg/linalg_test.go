@@ -0,0 +1,249 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/nune/linalg"
+)
+
+// approxEqual returns whether or not both buffers
+// are equal within the given tolerance.
+func approxEqual(x, y []float64, tol float64) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	for i := range x {
+		if math.Abs(x[i]-y[i]) > tol {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newMatrix() nune.Tensor[float64] {
+	return nune.From[float64]([][]float64{
+		{2, 1, 1},
+		{4, -6, 0},
+		{-2, 7, 2},
+	})
+}
+
+func TestLU(t *testing.T) {
+	a := newMatrix()
+
+	p, l, u := linalg.LU(a)
+	if p.Err != nil || l.Err != nil || u.Err != nil {
+		t.Fatal("could not decompose the matrix")
+	}
+
+	if !approxEqual(p.MatMul(l).MatMul(u).Ravel(), a.Ravel(), 1e-12) {
+		t.Error("p l u does not reconstruct the matrix")
+	}
+
+	if p, _, _ := linalg.LU(nune.Zeros[float64](2, 3)); p.Err != linalg.ErrNotSquare {
+		t.Error("decomposed a non-square matrix")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	a := newMatrix()
+	b := nune.From[float64]([]float64{5, -2, 9})
+
+	x := linalg.Solve(a, b)
+	if !approxEqual(x.Ravel(), []float64{1, 1, 2}, 1e-12) {
+		t.Error("solution of the linear system is incorrect")
+	}
+
+	x = linalg.Solve(a, b.Reshape(3, 1))
+	if !approxEqual(x.Ravel(), []float64{1, 1, 2}, 1e-12) || x.Rank() != 2 {
+		t.Error("solution of the linear system with a matrix is incorrect")
+	}
+
+	singular := nune.From[float64]([][]float64{{1, 2}, {2, 4}})
+	if linalg.Solve(singular, nune.Ones[float64](2)).Err != linalg.ErrSingular {
+		t.Error("solved a singular system")
+	}
+
+	if linalg.Solve(a, nune.Ones[float64](2)).Err == nil {
+		t.Error("solved a system with mismatching shapes")
+	}
+}
+
+func TestInvDet(t *testing.T) {
+	a := newMatrix()
+
+	// a batch made of the matrix and its transpose
+	batch := nune.From[float64]([][][]float64{
+		{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}},
+		{{2, 4, -2}, {1, -6, 7}, {1, 0, 2}},
+	})
+
+	inv := linalg.Inv(batch)
+	eye := []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}
+	if !approxEqual(inv.MatMul(batch).Ravel(), append(eye, eye...), 1e-12) {
+		t.Error("batched inverse is incorrect")
+	}
+
+	if !approxEqual(linalg.Inv(a.Permute(1, 0)).Ravel(), inv.Index(1).Ravel(), 1e-12) {
+		t.Error("inverse of a transposed view is incorrect")
+	}
+
+	det := linalg.Det(batch)
+	if !approxEqual(det.Ravel(), []float64{-16, -16}, 1e-12) {
+		t.Error("batched determinant is incorrect")
+	}
+
+	sign, logabsdet := linalg.Slogdet(a)
+	if sign.Scalar() != -1 || math.Abs(logabsdet.Scalar()-math.Log(16)) > 1e-12 {
+		t.Error("sign and log determinant are incorrect")
+	}
+
+	singular := nune.From[float64]([][]float64{{1, 2}, {2, 4}})
+	if linalg.Det(singular).Scalar() != 0 {
+		t.Error("determinant of a singular matrix is not null")
+	}
+
+	if linalg.Inv(singular).Err != linalg.ErrSingular {
+		t.Error("inverted a singular matrix")
+	}
+}
+
+func TestQR(t *testing.T) {
+	a := nune.From[float64]([][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 1, 1}})
+
+	q, r := linalg.QR(a)
+	if q.Err != nil || r.Err != nil {
+		t.Fatal("could not decompose the matrix")
+	}
+
+	if !approxEqual(q.MatMul(r).Ravel(), a.Ravel(), 1e-10) {
+		t.Error("q r does not reconstruct the matrix")
+	}
+
+	qtq := q.Permute(1, 0).MatMul(q)
+	if !approxEqual(qtq.Ravel(), []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}, 1e-12) {
+		t.Error("q's columns are not orthonormal")
+	}
+
+	if r.Index(1, 0).Scalar() != 0 || r.Index(2, 1).Scalar() != 0 {
+		t.Error("r is not upper triangular")
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	a := nune.From[float32]([][]float32{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}})
+
+	l := linalg.Cholesky(a)
+	expected := []float32{2, 0, 0, 6, 1, 0, -8, 5, 3}
+	for i, x := range l.Ravel() {
+		if math.Abs(float64(x-expected[i])) > 1e-5 {
+			t.Fatal("cholesky factor is incorrect")
+		}
+	}
+
+	if linalg.Cholesky(newMatrix()).Err != linalg.ErrNotPositiveDefinite {
+		t.Error("decomposed a matrix that is not positive-definite")
+	}
+}
+
+func TestEigh(t *testing.T) {
+	a := nune.From[float64]([][][]float64{
+		{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+		{{4, 1, 2}, {1, 3, 0}, {2, 0, 5}},
+	})
+
+	w, v := linalg.Eigh(a)
+	if w.Err != nil || v.Err != nil {
+		t.Fatal("could not decompose the matrices")
+	}
+
+	if !approxEqual(w.Index(0).Ravel(), []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2}, 1e-12) {
+		t.Error("eigenvalues are incorrect")
+	}
+
+	// a v must equal v diag(w)
+	av := a.MatMul(v)
+	vw := scaleColumns(v.Ravel(), w.Ravel(), 3)
+	if !approxEqual(av.Ravel(), vw, 1e-10) {
+		t.Error("eigenvectors are incorrect")
+	}
+}
+
+func TestSVD(t *testing.T) {
+	for _, a := range []nune.Tensor[float64]{
+		nune.From[float64]([][]float64{{3, 2, 2}, {2, 3, -2}}),
+		nune.From[float64]([][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}}),
+		nune.From[float64]([][]float64{{1, 1}, {1, 1}, {0, 0}}),
+	} {
+		m, n := a.Size(0), a.Size(1)
+
+		u, s, vt := linalg.SVD(a, false)
+		us := nune.FromBuffer(scaleColumns(u.Ravel(), s.Ravel(), s.Size(0))).Reshape(m, s.Size(0))
+		if !approxEqual(us.MatMul(vt).Ravel(), a.Ravel(), 1e-10) {
+			t.Error("thin svd does not reconstruct the matrix")
+		}
+
+		u, _, vt = linalg.SVD(a, true)
+		if !approxEqual(u.Permute(1, 0).MatMul(u).Ravel(), eye(m), 1e-10) {
+			t.Error("full u is not orthogonal")
+		}
+
+		if !approxEqual(vt.MatMul(vt.Permute(1, 0)).Ravel(), eye(n), 1e-10) {
+			t.Error("full vt is not orthogonal")
+		}
+	}
+}
+
+func TestPinvLstsq(t *testing.T) {
+	a := nune.From[float64]([][]float64{{1, 1}, {1, 2}, {1, 3}})
+	b := nune.From[float64]([]float64{1, 2, 2})
+
+	x := linalg.Lstsq(a, b)
+	if !approxEqual(x.Ravel(), []float64{2.0 / 3, 0.5}, 1e-10) {
+		t.Error("least-squares solution is incorrect")
+	}
+
+	p := linalg.Pinv(a)
+	if !approxEqual(p.MatMul(a).Ravel(), eye(2), 1e-10) {
+		t.Error("pseudo-inverse is not a left inverse")
+	}
+
+	// rank-deficient matrix
+	p32 := linalg.Pinv(nune.From[float32]([][]float32{{1, 2}, {2, 4}}))
+	expected := []float32{0.04, 0.08, 0.08, 0.16}
+	for i, x := range p32.Ravel() {
+		if math.Abs(float64(x-expected[i])) > 1e-6 {
+			t.Fatal("pseudo-inverse of a rank-deficient matrix is incorrect")
+		}
+	}
+}
+
+// eye returns the row-major n by n identity matrix.
+func eye(n int) []float64 {
+	e := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		e[i*n+i] = 1
+	}
+	return e
+}
+
+// scaleColumns multiplies each column j of the row-major matrices
+// of the given number of columns held by m by the element j of the
+// corresponding row of s.
+func scaleColumns(m, s []float64, cols int) []float64 {
+	size := len(m) / (len(s) / cols) // elements per matrix
+
+	res := make([]float64, len(m))
+	for i := range m {
+		res[i] = m[i] * s[i/size*cols+i%cols]
+	}
+	return res
+}
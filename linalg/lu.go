@@ -0,0 +1,265 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"math"
+
+	"github.com/vorduin/nune"
+)
+
+// luFactor computes the LU decomposition with partial pivoting of the
+// row-major n by n matrix a in place, storing the unit lower triangular
+// factor below its diagonal and the upper triangular factor on and above it.
+// It returns the row permutation, such that row i of the factored matrix
+// is row piv[i] of the original one, the permutation's sign, and whether
+// or not the matrix is singular.
+func luFactor(a []float64, n int) ([]int, float64, bool) {
+	piv := make([]int, n)
+	for i := range piv {
+		piv[i] = i
+	}
+
+	sign := 1.0
+	singular := false
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i*n+k]) > math.Abs(a[p*n+k]) {
+				p = i
+			}
+		}
+
+		if a[p*n+k] == 0 {
+			singular = true
+			continue
+		}
+
+		if p != k {
+			for j := 0; j < n; j++ {
+				a[k*n+j], a[p*n+j] = a[p*n+j], a[k*n+j]
+			}
+			piv[k], piv[p] = piv[p], piv[k]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			a[i*n+k] /= a[k*n+k]
+			f := a[i*n+k]
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= f * a[k*n+j]
+			}
+		}
+	}
+
+	return piv, sign, singular
+}
+
+// luSolve solves the system a x = b, where a is given by its non-singular
+// LU decomposition and b is a row-major n by k matrix, returning x.
+func luSolve(lu []float64, piv []int, n int, b []float64, k int) []float64 {
+	x := make([]float64, n*k)
+	for i, p := range piv {
+		copy(x[i*k:(i+1)*k], b[p*k:(p+1)*k])
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			f := lu[i*n+j]
+			for c := 0; c < k; c++ {
+				x[i*k+c] -= f * x[j*k+c]
+			}
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			f := lu[i*n+j]
+			for c := 0; c < k; c++ {
+				x[i*k+c] -= f * x[j*k+c]
+			}
+		}
+		for c := 0; c < k; c++ {
+			x[i*k+c] /= lu[i*n+i]
+		}
+	}
+
+	return x
+}
+
+// LU computes the LU decomposition with partial pivoting of the
+// square matrices held by the Tensor, returning the permutation matrices p,
+// the unit lower triangular matrices l and the upper triangular matrices u,
+// such that a = p l u.
+func LU[F Float](a nune.Tensor[F]) (p, l, u nune.Tensor[F]) {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err), failed[F](err), failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	pData := make([]float64, len(data))
+	lData := make([]float64, len(data))
+	uData := make([]float64, len(data))
+
+	for b := 0; b < len(data); b += n * n {
+		lu := data[b : b+n*n]
+		piv, _, _ := luFactor(lu, n)
+
+		for i := 0; i < n; i++ {
+			pData[b+piv[i]*n+i] = 1
+			lData[b+i*n+i] = 1
+
+			for j := 0; j < n; j++ {
+				if j < i {
+					lData[b+i*n+j] = lu[i*n+j]
+				} else {
+					uData[b+i*n+j] = lu[i*n+j]
+				}
+			}
+		}
+	}
+
+	shape := a.Shape()
+
+	return pack[F](pData, shape...), pack[F](lData, shape...), pack[F](uData, shape...)
+}
+
+// Solve solves the linear systems a x = b, where a holds square matrices,
+// and b holds either vectors, if its rank is one less than a's rank, or
+// matrices otherwise. The leading axes of a and b must match.
+func Solve[F Float](a, b nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+
+	k, _, err := rhsLayout(a, b, n)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	aData := unpack(a)
+	bData := unpack(b)
+
+	x := make([]float64, 0, len(bData))
+	for i := 0; i < len(aData)/(n*n); i++ {
+		lu := aData[i*n*n : (i+1)*n*n]
+
+		piv, _, singular := luFactor(lu, n)
+		if singular {
+			return failed[F](ErrSingular)
+		}
+
+		x = append(x, luSolve(lu, piv, n, bData[i*n*k:(i+1)*n*k], k)...)
+	}
+
+	return pack[F](x, b.Shape()...)
+}
+
+// Inv computes the inverse of the square matrices held by the Tensor.
+func Inv[F Float](a nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	eye := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		eye[i*n+i] = 1
+	}
+
+	inv := make([]float64, 0, len(data))
+	for b := 0; b < len(data); b += n * n {
+		lu := data[b : b+n*n]
+
+		piv, _, singular := luFactor(lu, n)
+		if singular {
+			return failed[F](ErrSingular)
+		}
+
+		inv = append(inv, luSolve(lu, piv, n, eye, n)...)
+	}
+
+	return pack[F](inv, a.Shape()...)
+}
+
+// Det computes the determinant of the square matrices held by the Tensor.
+func Det[F Float](a nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	det := make([]float64, 0, len(data)/(n*n))
+	for b := 0; b < len(data); b += n * n {
+		lu := data[b : b+n*n]
+
+		_, d, singular := luFactor(lu, n)
+		if singular {
+			d = 0
+		}
+		for i := 0; i < n; i++ {
+			d *= lu[i*n+i]
+		}
+
+		det = append(det, d)
+	}
+
+	return pack[F](det, batchShape(a)...)
+}
+
+// Slogdet computes the sign and the natural logarithm of the absolute value
+// of the determinant of the square matrices held by the Tensor, which is
+// less prone to overflow and underflow than Det. The sign is 0 and the
+// logarithm is negative infinity for singular matrices.
+func Slogdet[F Float](a nune.Tensor[F]) (sign, logabsdet nune.Tensor[F]) {
+	err := verifyMatrix(a, true)
+	if err != nil {
+		return failed[F](err), failed[F](err)
+	}
+
+	n := a.Size(a.Rank() - 1)
+	data := unpack(a)
+
+	signs := make([]float64, 0, len(data)/(n*n))
+	logs := make([]float64, 0, len(data)/(n*n))
+	for b := 0; b < len(data); b += n * n {
+		lu := data[b : b+n*n]
+
+		_, s, singular := luFactor(lu, n)
+		if singular {
+			signs = append(signs, 0)
+			logs = append(logs, math.Inf(-1))
+			continue
+		}
+
+		var l float64
+		for i := 0; i < n; i++ {
+			if lu[i*n+i] < 0 {
+				s = -s
+			}
+			l += math.Log(math.Abs(lu[i*n+i]))
+		}
+
+		signs = append(signs, s)
+		logs = append(logs, l)
+	}
+
+	shape := batchShape(a)
+
+	return pack[F](signs, shape...), pack[F](logs, shape...)
+}
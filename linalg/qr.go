@@ -0,0 +1,123 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"math"
+
+	"github.com/vorduin/nune"
+)
+
+// qrFactor computes the reduced QR decomposition of the row-major
+// m by n matrix a using Householder reflections, returning the m by k
+// matrix q with orthonormal columns and the k by n upper triangular
+// matrix r, where k is the minimum of m and n.
+func qrFactor(a []float64, m, n int) ([]float64, []float64) {
+	k := m
+	if n < k {
+		k = n
+	}
+
+	r := make([]float64, m*n)
+	copy(r, a)
+
+	q := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		q[i*m+i] = 1
+	}
+
+	v := make([]float64, m)
+	for j := 0; j < k; j++ {
+		var norm float64
+		for i := j; i < m; i++ {
+			norm = math.Hypot(norm, r[i*n+j])
+		}
+		if norm == 0 {
+			continue
+		}
+
+		alpha := -math.Copysign(norm, r[j*n+j])
+		var vnorm float64
+		for i := j; i < m; i++ {
+			v[i] = r[i*n+j]
+			if i == j {
+				v[i] -= alpha
+			}
+			vnorm += v[i] * v[i]
+		}
+		if vnorm == 0 {
+			continue
+		}
+
+		// r = (I - 2vv'/v'v) r
+		for c := j; c < n; c++ {
+			var dot float64
+			for i := j; i < m; i++ {
+				dot += v[i] * r[i*n+c]
+			}
+			f := 2 * dot / vnorm
+			for i := j; i < m; i++ {
+				r[i*n+c] -= f * v[i]
+			}
+		}
+
+		// q = q (I - 2vv'/v'v)
+		for row := 0; row < m; row++ {
+			var dot float64
+			for i := j; i < m; i++ {
+				dot += q[row*m+i] * v[i]
+			}
+			f := 2 * dot / vnorm
+			for i := j; i < m; i++ {
+				q[row*m+i] -= f * v[i]
+			}
+		}
+	}
+
+	qk := make([]float64, m*k)
+	for i := 0; i < m; i++ {
+		copy(qk[i*k:(i+1)*k], q[i*m:i*m+k])
+	}
+
+	rk := make([]float64, k*n)
+	for i := 0; i < k; i++ {
+		for j := i; j < n; j++ {
+			rk[i*n+j] = r[i*n+j]
+		}
+	}
+
+	return qk, rk
+}
+
+// QR computes the reduced QR decomposition of the matrices held by the
+// Tensor, returning the matrices q with orthonormal columns and the upper
+// triangular matrices r, such that a = q r. For m by n matrices, q is
+// m by k and r is k by n, where k is the minimum of m and n.
+func QR[F Float](a nune.Tensor[F]) (q, r nune.Tensor[F]) {
+	err := verifyMatrix(a, false)
+	if err != nil {
+		return failed[F](err), failed[F](err)
+	}
+
+	m, n := a.Size(a.Rank()-2), a.Size(a.Rank()-1)
+	k := m
+	if n < k {
+		k = n
+	}
+
+	data := unpack(a)
+
+	qData := make([]float64, 0, len(data)/(m*n)*m*k)
+	rData := make([]float64, 0, len(data)/(m*n)*k*n)
+	for b := 0; b < len(data); b += m * n {
+		qb, rb := qrFactor(data[b:b+m*n], m, n)
+		qData = append(qData, qb...)
+		rData = append(rData, rb...)
+	}
+
+	batch := batchShape(a)
+
+	return pack[F](qData, withShape(batch, m, k)...), pack[F](rData, withShape(batch, k, n)...)
+}
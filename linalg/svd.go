@@ -0,0 +1,321 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vorduin/nune"
+)
+
+// transpose returns the transpose of the row-major m by n matrix a.
+func transpose(a []float64, m, n int) []float64 {
+	t := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			t[j*m+i] = a[i*n+j]
+		}
+	}
+	return t
+}
+
+// complete fills the columns [k, cols) of the row-major m by cols matrix u
+// so that all of its columns are orthonormal, given that its first k columns
+// already are, using Gram-Schmidt orthogonalization of the canonical basis.
+func complete(u []float64, m, cols, k int) {
+	col := make([]float64, m)
+
+	for e := 0; e < m && k < cols; e++ {
+		for i := range col {
+			col[i] = 0
+		}
+		col[e] = 1
+
+		// orthogonalize twice for numerical stability
+		for pass := 0; pass < 2; pass++ {
+			for j := 0; j < k; j++ {
+				var dot float64
+				for i := 0; i < m; i++ {
+					dot += u[i*cols+j] * col[i]
+				}
+				for i := 0; i < m; i++ {
+					col[i] -= dot * u[i*cols+j]
+				}
+			}
+		}
+
+		var norm float64
+		for _, x := range col {
+			norm = math.Hypot(norm, x)
+		}
+		if norm < 1e-8 {
+			continue
+		}
+
+		for i := 0; i < m; i++ {
+			u[i*cols+k] = col[i] / norm
+		}
+		k++
+	}
+}
+
+// svdFactor computes the singular value decomposition of the row-major
+// m by n matrix a, where m >= n, using the one-sided Jacobi algorithm.
+// It returns the m by ucols matrix u, the n singular values in descending
+// order, and the n by n matrix v, where ucols is m if full is true and n otherwise.
+func svdFactor(a []float64, m, n int, full bool) ([]float64, []float64, []float64) {
+	w := make([]float64, m*n)
+	copy(w, a)
+
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		rotated := false
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma float64
+				for i := 0; i < m; i++ {
+					alpha += w[i*n+p] * w[i*n+p]
+					beta += w[i*n+q] * w[i*n+q]
+					gamma += w[i*n+p] * w[i*n+q]
+				}
+				if gamma == 0 || math.Abs(gamma) <= 1e-15*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				for i := 0; i < m; i++ {
+					ip, iq := w[i*n+p], w[i*n+q]
+					w[i*n+p] = c*ip - s*iq
+					w[i*n+q] = s*ip + c*iq
+				}
+				for i := 0; i < n; i++ {
+					ip, iq := v[i*n+p], v[i*n+q]
+					v[i*n+p] = c*ip - s*iq
+					v[i*n+q] = s*ip + c*iq
+				}
+			}
+		}
+
+		if !rotated {
+			break
+		}
+	}
+
+	sigma := make([]float64, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			sigma[j] = math.Hypot(sigma[j], w[i*n+j])
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sigma[order[i]] > sigma[order[j]]
+	})
+
+	ucols := n
+	if full {
+		ucols = m
+	}
+
+	u := make([]float64, m*ucols)
+	s := make([]float64, n)
+	vs := make([]float64, n*n)
+
+	// columns of u whose singular value is negligible are
+	// completed to an orthonormal basis instead
+	tol := float64(m) * 1e-15 * sigma[order[0]]
+	k := 0
+	for j, o := range order {
+		s[j] = sigma[o]
+		for i := 0; i < n; i++ {
+			vs[i*n+j] = v[i*n+o]
+		}
+		if sigma[o] > tol {
+			for i := 0; i < m; i++ {
+				u[i*ucols+j] = w[i*n+o] / sigma[o]
+			}
+			k++
+		}
+	}
+	complete(u, m, ucols, k)
+
+	return u, s, vs
+}
+
+// svd computes the singular value decomposition of the row-major
+// m by n matrix a, returning u, the singular values in descending
+// order, and v', such that a = u diag(s) v'.
+// If full is true, u is m by m and v' is n by n, otherwise
+// u is m by k and v' is k by n, where k is the minimum of m and n.
+func svd(a []float64, m, n int, full bool) ([]float64, []float64, []float64) {
+	if m >= n {
+		u, s, v := svdFactor(a, m, n, full)
+		return u, s, transpose(v, n, n)
+	}
+
+	// a' = v diag(s) u', therefore a = u diag(s) v'
+	v, s, u := svdFactor(transpose(a, m, n), n, m, full)
+	vcols := m
+	if full {
+		vcols = n
+	}
+
+	return u, s, transpose(v, n, vcols)
+}
+
+// SVD computes the singular value decomposition of the matrices held by
+// the Tensor, returning u, the singular values s in descending order, and vt,
+// such that a = u diag(s) vt. For m by n matrices, if full is true, u is
+// m by m and vt is n by n, otherwise u is m by k and vt is k by n,
+// where k is the minimum of m and n.
+func SVD[F Float](a nune.Tensor[F], full bool) (u, s, vt nune.Tensor[F]) {
+	err := verifyMatrix(a, false)
+	if err != nil {
+		return failed[F](err), failed[F](err), failed[F](err)
+	}
+
+	m, n := a.Size(a.Rank()-2), a.Size(a.Rank()-1)
+	k := m
+	if n < k {
+		k = n
+	}
+	ucols, vrows := k, k
+	if full {
+		ucols, vrows = m, n
+	}
+
+	data := unpack(a)
+
+	var uData, sData, vData []float64
+	for b := 0; b < len(data); b += m * n {
+		ub, sb, vb := svd(data[b:b+m*n], m, n, full)
+		uData = append(uData, ub...)
+		sData = append(sData, sb...)
+		vData = append(vData, vb...)
+	}
+
+	batch := batchShape(a)
+
+	return pack[F](uData, withShape(batch, m, ucols)...),
+		pack[F](sData, withShape(batch, k)...),
+		pack[F](vData, withShape(batch, vrows, n)...)
+}
+
+// pinv computes the pseudo-inverse of the row-major m by n matrix a,
+// treating singular values smaller than rcond times the largest
+// singular value as zero.
+func pinv(a []float64, m, n int, rcond float64) []float64 {
+	u, s, vt := svd(a, m, n, false)
+	k := len(s)
+
+	cutoff := rcond * s[0]
+
+	p := make([]float64, n*m)
+	for l := 0; l < k; l++ {
+		if s[l] <= cutoff || s[l] == 0 {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			f := vt[l*n+i] / s[l]
+			for j := 0; j < m; j++ {
+				p[i*m+j] += f * u[j*k+l]
+			}
+		}
+	}
+
+	return p
+}
+
+// defaultRcond returns the default relative cutoff
+// for small singular values of an m by n matrix.
+func defaultRcond(m, n int) float64 {
+	if n > m {
+		m = n
+	}
+	return float64(m) * 0x1p-52
+}
+
+// Pinv computes the Moore-Penrose pseudo-inverse of the matrices held by
+// the Tensor. Singular values smaller than max(m, n) times the machine
+// epsilon times the largest singular value are treated as zero.
+func Pinv[F Float](a nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, false)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	m, n := a.Size(a.Rank()-2), a.Size(a.Rank()-1)
+	data := unpack(a)
+
+	p := make([]float64, 0, len(data))
+	for b := 0; b < len(data); b += m * n {
+		p = append(p, pinv(data[b:b+m*n], m, n, defaultRcond(m, n))...)
+	}
+
+	return pack[F](p, withShape(batchShape(a), n, m)...)
+}
+
+// Lstsq computes the least-squares solutions x minimizing the euclidean
+// norm of a x - b, where a holds m by n matrices, and b holds either
+// vectors of length m, if its rank is one less than a's rank, or m by k
+// matrices otherwise. The leading axes of a and b must match.
+// Rank-deficient systems return the solution of minimum norm.
+func Lstsq[F Float](a, b nune.Tensor[F]) nune.Tensor[F] {
+	err := verifyMatrix(a, false)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	m, n := a.Size(a.Rank()-2), a.Size(a.Rank()-1)
+	batch := batchShape(a)
+
+	k, vector, err := rhsLayout(a, b, m)
+	if err != nil {
+		return failed[F](err)
+	}
+
+	aData := unpack(a)
+	bData := unpack(b)
+
+	x := make([]float64, 0, len(aData)/(m*n)*n*k)
+	for i := 0; i < len(aData)/(m*n); i++ {
+		p := pinv(aData[i*m*n:(i+1)*m*n], m, n, defaultRcond(m, n))
+		bb := bData[i*m*k : (i+1)*m*k]
+
+		for r := 0; r < n; r++ {
+			for c := 0; c < k; c++ {
+				var sum float64
+				for j := 0; j < m; j++ {
+					sum += p[r*m+j] * bb[j*k+c]
+				}
+				x = append(x, sum)
+			}
+		}
+	}
+
+	if vector {
+		return pack[F](x, withShape(batch, n)...)
+	}
+
+	return pack[F](x, withShape(batch, n, k)...)
+}
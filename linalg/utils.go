@@ -0,0 +1,108 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+// Float is the set of all floating-point types and their supersets.
+type Float interface {
+	~float32 | ~float64
+}
+
+// failed returns a Tensor holding the given error,
+// or panics if the environment is interactive.
+func failed[F Float](err error) nune.Tensor[F] {
+	if nune.EnvConfig.Interactive {
+		panic(err)
+	}
+
+	return nune.Tensor[F]{
+		Err: err,
+	}
+}
+
+// unpack returns the Tensor's elements in row-major order
+// as a float64 buffer, following the Tensor's layout.
+func unpack[F Float](t nune.Tensor[F]) []float64 {
+	if t.Rank() == 0 {
+		return []float64{float64(t.Scalar())}
+	}
+
+	data := make([]float64, 0, t.Numel())
+
+	var walk func(v nune.Tensor[F])
+	walk = func(v nune.Tensor[F]) {
+		if v.Rank() > 1 {
+			for i := 0; i < v.Size(0); i++ {
+				walk(v.Index(i))
+			}
+		} else if v.Stride()[0] == 1 {
+			for _, x := range v.Ravel() {
+				data = append(data, float64(x))
+			}
+		} else {
+			for i := 0; i < v.Size(0); i++ {
+				data = append(data, float64(v.Index(i).Scalar()))
+			}
+		}
+	}
+	walk(t)
+
+	return data
+}
+
+// pack returns a Tensor with the given shape
+// from a row-major float64 buffer.
+func pack[F Float](data []float64, shape ...int) nune.Tensor[F] {
+	buf := make([]F, len(data))
+	for i, x := range data {
+		buf[i] = F(x)
+	}
+
+	return nune.FromBuffer(buf).Reshape(shape...)
+}
+
+// batchShape returns the Tensor's shape without its last two axes.
+func batchShape[F Float](t nune.Tensor[F]) []int {
+	shape := t.Shape()
+	return shape[:len(shape)-2]
+}
+
+// withShape returns the given leading shape followed by the given axes.
+func withShape(lead []int, axes ...int) []int {
+	shape := make([]int, 0, len(lead)+len(axes))
+	shape = append(shape, lead...)
+	return append(shape, axes...)
+}
+
+// rhsLayout returns the number of columns of the right-hand sides held by b,
+// for the systems of equations whose matrices with the given number of rows
+// are held by a, and whether or not b holds vectors rather than matrices.
+func rhsLayout[F Float](a, b nune.Tensor[F], rows int) (int, bool, error) {
+	if b.Err != nil {
+		return 0, false, b.Err
+	}
+
+	vector := b.Rank() == a.Rank()-1
+	if !vector && b.Rank() != a.Rank() {
+		return 0, false, nune.ErrShapeMismatch
+	}
+
+	shape := b.Shape()
+	k := 1
+	if !vector {
+		k = shape[len(shape)-1]
+		shape = shape[:len(shape)-1]
+	}
+
+	if !slices.Equal(shape, withShape(batchShape(a), rows)) {
+		return 0, false, nune.ErrShapeMismatch
+	}
+
+	return k, vector, nil
+}
@@ -0,0 +1,48 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linalg
+
+import (
+	"errors"
+
+	"github.com/vorduin/nune"
+)
+
+// List of errors.
+var (
+	// ErrNotMatrix occurs when a Tensor's rank is less than 2,
+	// therefore not holding any matrix.
+	ErrNotMatrix = errors.New("linalg: tensor is not a matrix")
+
+	// ErrNotSquare occurs when a routine expecting square
+	// matrices receives a non-square matrix.
+	ErrNotSquare = errors.New("linalg: matrix is not square")
+
+	// ErrSingular occurs when a matrix is singular
+	// and therefore cannot be inverted.
+	ErrSingular = errors.New("linalg: matrix is singular")
+
+	// ErrNotPositiveDefinite occurs when a matrix that is expected
+	// to be symmetric positive-definite is not.
+	ErrNotPositiveDefinite = errors.New("linalg: matrix is not positive-definite")
+)
+
+// verifyMatrix makes sure the Tensor holds matrices,
+// and that they are square if required.
+func verifyMatrix[F Float](t nune.Tensor[F], square bool) error {
+	if t.Err != nil {
+		return t.Err
+	}
+
+	if t.Rank() < 2 {
+		return ErrNotMatrix
+	}
+
+	if square && t.Size(t.Rank()-1) != t.Size(t.Rank()-2) {
+		return ErrNotSquare
+	}
+
+	return nil
+}
@@ -22,21 +22,22 @@ func Cast[T Number, V Number](t Tensor[V]) Tensor[T] {
 		}
 	}
 
-	dataBuf := t.Ravel()
+	dataBuf := t.gather(0, t.Numel(), nil)
 	c := slices.WithLen[T](t.Numel())
 	for i := 0; i < len(c); i++ {
 		c[i] = T(dataBuf[i])
 	}
 
+	shape := slices.Clone(t.shape)
+
 	return Tensor[T]{
 		data:   c,
-		shape:  t.shape,
-		stride: t.stride,
-		offset: t.offset,
+		shape:  shape,
+		stride: configStride(shape),
 	}
 }
 
-// Clone clones the Tensor and its underlying view into its data buffer.
+// Clone clones the Tensor's elements into a new contiguous data buffer.
 func (t Tensor[T]) Clone() Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -46,14 +47,37 @@ func (t Tensor[T]) Clone() Tensor[T] {
 		}
 	}
 
-	return Tensor[T]{
-		data:   slices.Clone(t.Ravel()),
-		shape:  slices.Clone(t.shape),
-		stride: slices.Clone(t.stride),
+	return t.materialize(t.shape...)
+}
+
+// IsContiguous returns whether or not the Tensor's elements
+// are laid out in row-major order without gaps in its data buffer.
+func (t Tensor[T]) IsContiguous() bool {
+	return isContiguous(t.shape, t.stride)
+}
+
+// Contiguous returns the Tensor if it's contiguous,
+// or a contiguous copy of it otherwise.
+func (t Tensor[T]) Contiguous() Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
 	}
+
+	if t.IsContiguous() {
+		return t
+	}
+
+	return t.materialize(t.shape...)
 }
 
-// Reshape modifies the Tensor's indexing scheme.
+// Reshape returns the Tensor with the given shape, which must hold the
+// same number of elements. One of the dimensions might be -1, in which
+// case it's inferred from the others. The result is a view over the Tensor
+// whenever its layout allows it, and a contiguous copy otherwise.
 func (t Tensor[T]) Reshape(shape ...int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -63,43 +87,151 @@ func (t Tensor[T]) Reshape(shape ...int) Tensor[T] {
 		}
 	}
 
-	if len(shape) == 0 && t.Numel() <= 1 {
-		return Tensor[T]{
-			data:   t.data,
-			offset: t.offset,
+	shape, err := inferShape(t.Numel(), shape)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
 		}
-	} else {
-		err := verifyGoodShape(shape...)
-		if err != nil {
-			if EnvConfig.Interactive {
-				panic(err)
-			} else {
-				t.Err = err
-				return t
-			}
+	}
+
+	stride, ok := viewStride(t.shape, t.stride, shape)
+	if !ok {
+		return t.materialize(shape...)
+	}
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  shape,
+		stride: stride,
+		offset: t.offset,
+	}
+}
+
+// View returns a view over the Tensor with the given shape, as Reshape
+// does, but fails instead of copying the Tensor if its layout doesn't allow
+// the view, such as for some permuted Tensors.
+func (t Tensor[T]) View(shape ...int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
 		}
-		
-		newstride := slices.WithLen[int](len(shape))
-		if len(shape) <= len(t.shape) {
-			copy(newstride, t.stride[len(t.stride)-len(shape):])
+	}
+
+	shape, err := inferShape(t.Numel(), shape)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
 		} else {
-			copy(newstride[len(shape)-len(t.stride):], t.stride)
-			for i := len(shape)-len(t.stride)-1; i >= 0; i-- {
-				newstride[i] = shape[i+1] * newstride[i+1]
-			}
+			t.Err = err
+			return t
 		}
+	}
 
-		return Tensor[T]{
-			data:   t.data,
-			shape:  slices.Clone(shape),
-			stride: newstride,
-			offset: t.offset,
+	stride, ok := viewStride(t.shape, t.stride, shape)
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrViewCopy)
+		} else {
+			t.Err = ErrViewCopy
+			return t
 		}
 	}
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  shape,
+		stride: stride,
+		offset: t.offset,
+	}
+}
+
+// Flatten merges the axes from startAxis to endAxis, inclusive, into a
+// single axis, as Reshape does.
+func (t Tensor[T]) Flatten(startAxis, endAxis int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	startAxis, err := normAxis("Flatten", startAxis, len(t.shape))
+	if err == nil {
+		endAxis, err = normAxis("Flatten", endAxis, len(t.shape))
+	}
+	if err == nil && endAxis < startAxis {
+		err = &AxisError{
+			Method: "Flatten",
+			Axis:   endAxis,
+			Rank:   len(t.shape),
+			Err:    ErrAxisBounds,
+		}
+	}
+
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape := slices.Clone(t.shape[:startAxis])
+	shape = append(shape, slices.Prod(t.shape[startAxis:endAxis+1]))
+	shape = append(shape, t.shape[endAxis+1:]...)
+
+	return t.Reshape(shape...)
+}
+
+// Unflatten splits the given axis into several axes of the given
+// dimensions, as Reshape does. One of the dimensions might be -1,
+// in which case it's inferred from the others.
+func (t Tensor[T]) Unflatten(axis int, sizes ...int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	axis, err := normAxis("Unflatten", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	sizes, err = inferShape(t.shape[axis], sizes)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape := slices.Clone(t.shape[:axis])
+	shape = append(shape, sizes...)
+	shape = append(shape, t.shape[axis+1:]...)
+
+	return t.Reshape(shape...)
 }
 
 // Index returns a view over an index of the Tensor.
-// Multiple indices can be provided at the same time.
+// Multiple indices can be provided at the same time, negative
+// indices counting from the end of their axis.
 func (t Tensor[T]) Index(indices ...int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -119,9 +251,21 @@ func (t Tensor[T]) Index(indices ...int) Tensor[T] {
 		}
 	}
 
+	offset := t.offset
+
 	for i, idx := range indices {
-		err = verifyAxisBounds(idx, t.Size(i))
-		if err != nil {
+		n := idx
+		if n < 0 {
+			n += t.shape[i]
+		}
+
+		if n < 0 || n >= t.shape[i] {
+			err := &IndexError{
+				Index: idx,
+				Axis:  i,
+				Size:  t.shape[i],
+			}
+
 			if EnvConfig.Interactive {
 				panic(err)
 			} else {
@@ -129,12 +273,8 @@ func (t Tensor[T]) Index(indices ...int) Tensor[T] {
 				return t
 			}
 		}
-	}
 
-	offset := t.offset
-
-	for i, idx := range indices {
-		offset += idx * t.stride[i]
+		offset += n * t.stride[i]
 	}
 
 	return Tensor[T]{
@@ -187,7 +327,9 @@ func (t Tensor[T]) Slice(start, end int) Tensor[T] {
 	}
 }
 
-// Broadcast broadcasts the Tensor to the given shape.
+// Broadcast returns a view over the Tensor broadcast to the given shape,
+// without copying its data. The axes along which the Tensor is repeated
+// have a null stride, so the view can't be written to.
 func (t Tensor[T]) Broadcast(shape ...int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -206,49 +348,11 @@ func (t Tensor[T]) Broadcast(shape ...int) Tensor[T] {
 		}
 	}
 
-	var expandedShape []int
-
-	if len(t.shape) < len(shape) {
-		expandedShape = slices.WithLen[int](len(shape))
-		for i := 0; i < len(shape)-len(t.shape); i++ {
-			expandedShape[i] = 1
-		}
-		copy(expandedShape[len(shape)-len(t.shape):], t.shape)
-	} else {
-		expandedShape = t.shape
-	}
-
-	expandedStride := configStride(expandedShape)
-	newStride := configStride(shape)
-
-	data := slices.WithLen[T](int(slices.Prod(shape)))
-
-	var expansion, stride int = 1, newStride[0]
-
-	// This is around 20% slower on average the the shortened version
-	// I also came up with, but this one generalizes correctly so...
-	for axis := 0; axis < len(shape); axis++ {
-		if expandedShape[axis] != shape[axis] {
-			for i := 0; i < expansion; i++ {
-				for j := 0; j < t.Numel()/expandedStride[axis]; j++ {
-					for k := 0; k < shape[axis]; k++ {
-						dstIdx := i*stride + j*shape[axis] + k*newStride[axis]
-						srcIdx := j * expandedStride[axis]
-
-						copy(data[dstIdx:dstIdx+newStride[axis]], t.Ravel()[srcIdx:srcIdx+expandedStride[axis]])
-					}
-				}
-			}
-
-			expansion *= shape[axis]
-			stride = newStride[axis]
-		}
-	}
-
 	return Tensor[T]{
-		data: data,
-		shape: slices.Clone(shape),
-		stride: newStride,
+		data:   t.data,
+		shape:  slices.Clone(shape),
+		stride: t.broadcastStride(shape),
+		offset: t.offset,
 	}
 }
 
@@ -262,8 +366,25 @@ func (t Tensor[T]) Reverse() Tensor[T] {
 		}
 	}
 
-	for i, j := 0, t.Numel()-1; i < j; i, j = i+1, j-1 {
-		t.data[t.offset+i], t.data[t.offset+j] = t.data[t.offset+j], t.data[t.offset+i]
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	if t.IsContiguous() {
+		for i, j := 0, t.Numel()-1; i < j; i, j = i+1, j-1 {
+			t.data[t.offset+i], t.data[t.offset+j] = t.data[t.offset+j], t.data[t.offset+i]
+		}
+	} else {
+		for i, j := 0, t.Numel()-1; i < j; i, j = i+1, j-1 {
+			p, q := t.position(i), t.position(j)
+			t.data[p], t.data[q] = t.data[q], t.data[p]
+		}
 	}
 
 	return t
@@ -280,7 +401,7 @@ func (t Tensor[T]) Flip(axis int) Tensor[T] {
 		}
 	}
 
-	err := verifyAxisBounds(0, len(t.shape))
+	err := verifyWritable(t.shape, t.stride)
 	if err != nil {
 		if EnvConfig.Interactive {
 			panic(err)
@@ -290,19 +411,33 @@ func (t Tensor[T]) Flip(axis int) Tensor[T] {
 		}
 	}
 
-	stride := t.stride[axis]
-	for i := 0; i < t.Numel(); i += t.shape[axis] * stride {
-		for j, k := 0, t.shape[axis]-1; j < k; j, k = j+1, k-1 {
-			for l := 0; l < t.stride[axis]; l++ {
-				t.data[t.offset+i+j*stride+l], t.data[t.offset+i+k*stride+l] = t.data[t.offset+i+k*stride+l], t.data[t.offset+i+j*stride+l]
-			}
+	axis, err = normAxis("Flip", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
 		}
 	}
 
+	// swap the first half of the axis with its mirror
+	half := slices.Clone(t.shape)
+	half[axis] /= 2
+
+	mirror := slices.Clone(t.stride)
+	mirror[axis] = -mirror[axis]
+	end := t.offset + (t.shape[axis]-1)*t.stride[axis]
+
+	handleLayouts(half, [][]int{t.stride, mirror}, []int{t.offset, end}, func(pos []int) {
+		t.data[pos[0]], t.data[pos[1]] = t.data[pos[1]], t.data[pos[0]]
+	}, configCPU(t.Numel()/2))
+
 	return t
 }
 
-// Repeat repeats the elements of the array n times.
+// Repeat repeats the elements of the array n times, along a new leading
+// axis. See Tile and RepeatInterleave to repeat along existing axes.
 func (t Tensor[T]) Repeat(n int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -313,28 +448,28 @@ func (t Tensor[T]) Repeat(n int) Tensor[T] {
 	}
 
 	numel := t.Numel()
-	dataBuf := t.Ravel()
+	dataBuf := t.gather(0, numel, nil)
 	data := slices.WithLen[T](n * numel)
 	for i := 0; i < n; i++ {
 		copy(data[i*numel:i*numel+numel], dataBuf)
 	}
 
 	shape := slices.WithLen[int](len(t.shape) + 1)
-	stride := slices.WithLen[int](len(t.stride) + 1)
-
 	shape[0] = n
 	copy(shape[1:], t.shape)
-	stride[0] = t.shape[0] * t.stride[0]
-	copy(stride[1:], t.stride)
 
 	return Tensor[T]{
 		data: data,
 		shape: shape,
-		stride: stride,
+		stride: configStride(shape),
 	}
 }
 
-// Permute permutes the Tensor's axes without changing the data.
+// Permute returns a view over the Tensor with its axes permuted, the i-th
+// axis of the view being the given i-th axis of the Tensor. Every axis
+// must be given exactly once, otherwise Permute fails with ErrBadPermutation
+// if the number of axes doesn't match the Tensor's rank, or with an AxisError
+// if an axis is out of bounds or repeated.
 func (t Tensor[T]) Permute(axes ...int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -344,7 +479,16 @@ func (t Tensor[T]) Permute(axes ...int) Tensor[T] {
 		}
 	}
 
-	err := verifyArgsBounds(len(axes), len(t.shape))
+	if len(axes) != len(t.shape) {
+		if EnvConfig.Interactive {
+			panic(ErrBadPermutation)
+		} else {
+			t.Err = ErrBadPermutation
+			return t
+		}
+	}
+
+	axes, err := normAxes("Permute", len(t.shape), axes...)
 	if err != nil {
 		if EnvConfig.Interactive {
 			panic(err)
@@ -361,16 +505,6 @@ func (t Tensor[T]) Permute(axes ...int) Tensor[T] {
 	newstride := slices.WithLen[int](len(t.stride))
 
 	for i, axis := range axes {
-		err := verifyAxisBounds(axis, len(t.shape))
-		if err != nil {
-			if EnvConfig.Interactive {
-				panic(err)
-			} else {
-				t.Err = err
-				return t
-			}
-		}
-
 		newshape[i] = shapeCopy[axis]
 		newstride[i] = strideCopy[axis]
 	}
@@ -383,8 +517,20 @@ func (t Tensor[T]) Permute(axes ...int) Tensor[T] {
 	}
 }
 
-// Cat concatenates the other Tensor to this Tensor along the given axis.
-func (t Tensor[T]) Cat(other Tensor[T], axis int) Tensor[T] {
+// Transpose returns a view over the Tensor with the two given axes swapped.
+func (t Tensor[T]) Transpose(a, b int) Tensor[T] {
+	return t.swapAxes("Transpose", a, b)
+}
+
+// SwapAxes returns a view over the Tensor with the two given axes swapped.
+// It's an alias of Transpose.
+func (t Tensor[T]) SwapAxes(a, b int) Tensor[T] {
+	return t.swapAxes("SwapAxes", a, b)
+}
+
+// swapAxes returns a view over the Tensor with the two given
+// axes swapped, with errors naming the given method.
+func (t Tensor[T]) swapAxes(method string, a, b int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
 			panic(t.Err)
@@ -393,16 +539,11 @@ func (t Tensor[T]) Cat(other Tensor[T], axis int) Tensor[T] {
 		}
 	}
 
-	if other.Err != nil {
-		if EnvConfig.Interactive {
-			panic("nune: could not concatenate the two tensors")
-		} else {
-			t.Err = errors.New("nune: could not concatenate the two tensors")
-			return t
-		}
+	a, err := normAxis(method, a, len(t.shape))
+	if err == nil {
+		b, err = normAxis(method, b, len(t.shape))
 	}
 
-	err := verifyAxisBounds(axis, len(t.shape))
 	if err != nil {
 		if EnvConfig.Interactive {
 			panic(err)
@@ -412,42 +553,55 @@ func (t Tensor[T]) Cat(other Tensor[T], axis int) Tensor[T] {
 		}
 	}
 
-	if !slices.Equal(t.shape[:axis], other.shape[:axis]) || !slices.Equal(t.shape[axis+1:], other.shape[axis+1:]) {
+	axes := make([]int, len(t.shape))
+	for i := range axes {
+		axes[i] = i
+	}
+	axes[a], axes[b] = b, a
+
+	return t.Permute(axes...)
+}
+
+// MoveAxis returns a view over the Tensor with the axis src moved to
+// the position dst, the other axes keeping their relative order.
+func (t Tensor[T]) MoveAxis(src, dst int) Tensor[T] {
+	if t.Err != nil {
 		if EnvConfig.Interactive {
-			panic("nune: tensors' shapes do not allow concatenating them")
+			panic(t.Err)
 		} else {
-			t.Err = errors.New(("nune: tensors' shapes do not allow concatenating them"))
 			return t
 		}
 	}
 
-	newshape := slices.Clone(t.shape)
-	newshape[axis] += other.shape[axis]
-	newstride := configStride(newshape)
-
-	ts := t.stride[axis]
-	os := other.stride[axis]
-	ns := newstride[axis]
-	data := slices.WithLen[T](t.Numel() + other.Numel())
-
-	// how do I come up with these algorithms...
-	for i := 0; i < t.Numel()/(ts*t.shape[axis]); i++ {
-		copy(data[i*ns*newshape[axis]:i*ns*newshape[axis]+ts*t.shape[axis]], t.Ravel()[i*ts*t.shape[axis]:(i+1)*ts*t.shape[axis]])
+	src, err := normAxis("MoveAxis", src, len(t.shape))
+	if err == nil {
+		dst, err = normAxis("MoveAxis", dst, len(t.shape))
 	}
 
-	for i := 0; i < other.Numel()/(os*other.shape[axis]); i++ {
-		copy(data[i*ns*newshape[axis]+ts*t.shape[axis]:(i+1)*ns*newshape[axis]], other.Ravel()[i*os*other.shape[axis]:(i+1)*os*other.shape[axis]])
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
 	}
 
-	return Tensor[T]{
-		data: data,
-		shape: newshape,
-		stride: newstride,
+	axes := make([]int, 0, len(t.shape))
+	for i := range t.shape {
+		if i != src {
+			axes = append(axes, i)
+		}
 	}
+
+	axes = append(axes[:dst], append([]int{src}, axes[dst:]...)...)
+
+	return t.Permute(axes...)
 }
 
-// Stack stacks this and the other Tensor together along a new axis.
-func (t Tensor[T]) Stack(other Tensor[T], axis int) Tensor[T] {
+// T returns a view over the Tensor with all of its axes reversed,
+// which is the transpose of a matrix.
+func (t Tensor[T]) T() Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
 			panic(t.Err)
@@ -456,30 +610,51 @@ func (t Tensor[T]) Stack(other Tensor[T], axis int) Tensor[T] {
 		}
 	}
 
-	if other.Err != nil {
+	axes := make([]int, len(t.shape))
+	for i := range axes {
+		axes[i] = len(axes) - 1 - i
+	}
+
+	return t.Permute(axes...)
+}
+
+// Cat concatenates the other Tensor to this Tensor along the given axis.
+func (t Tensor[T]) Cat(other Tensor[T], axis int) Tensor[T] {
+	if t.Err != nil {
 		if EnvConfig.Interactive {
-			panic("nune: could not concatenate the two tensors")
+			panic(t.Err)
 		} else {
-			t.Err = errors.New("nune: could not concatenate the two tensors")
 			return t
 		}
 	}
 
-	err := verifyAxisBounds(axis, len(t.shape))
-	if err != nil {
+	out := concat("Cat", axis, []Tensor[T]{t, other})
+	if out.Err != nil {
+		t.Err = out.Err
+		return t
+	}
+
+	return out
+}
+
+// Stack stacks this and the other Tensor together along a new axis,
+// the axis being within [-rank-1, rank] bounds.
+func (t Tensor[T]) Stack(other Tensor[T], axis int) Tensor[T] {
+	if t.Err != nil {
 		if EnvConfig.Interactive {
-			panic(err)
+			panic(t.Err)
 		} else {
-			t.Err = err
 			return t
 		}
 	}
 
-	t = t.Unsqueeze(axis)
-	other = other.Unsqueeze(axis)
-	t = t.Cat(other, axis)
+	out := stack("Stack", axis, []Tensor[T]{t, other})
+	if out.Err != nil {
+		t.Err = out.Err
+		return t
+	}
 
-	return t
+	return out
 }
 
 // Squeeze removes an axis of dimensions 1 from the Tensor's shape.
@@ -492,7 +667,7 @@ func (t Tensor[T]) Squeeze(axis int) Tensor[T] {
 		}
 	}
 
-	err := verifyAxisBounds(axis, len(t.shape))
+	axis, err := normAxis("Squeeze", axis, len(t.shape))
 	if err != nil {
 		if EnvConfig.Interactive {
 			panic(err)
@@ -527,7 +702,8 @@ func (t Tensor[T]) Squeeze(axis int) Tensor[T] {
 	}
 }
 
-// Unsqueeze adds an axis of dimensions 1 to the Tensor's shape.
+// Unsqueeze adds an axis of dimensions 1 to the Tensor's shape,
+// the axis being within [-rank-1, rank] bounds.
 func (t Tensor[T]) Unsqueeze(axis int) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -537,7 +713,7 @@ func (t Tensor[T]) Unsqueeze(axis int) Tensor[T] {
 		}
 	}
 
-	err := verifyAxisBounds(axis, len(t.shape))
+	axis, err := normInsertAxis("Unsqueeze", axis, len(t.shape))
 	if err != nil {
 		if EnvConfig.Interactive {
 			panic(err)
@@ -40,7 +40,23 @@ func (t Tensor[T]) Map(f func(T) T) Tensor[T] {
 		}
 	}
 
-	handleMap(t.Ravel(), t.Ravel(), f, configCPU(t.Numel()))
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	if t.IsContiguous() {
+		handleMap(t.Ravel(), t.Ravel(), f, configCPU(t.Numel()))
+	} else {
+		handleLayouts(t.shape, [][]int{t.stride}, []int{t.offset}, func(pos []int) {
+			t.data[pos[0]] = f(t.data[pos[0]])
+		}, configCPU(t.Numel()))
+	}
 
 	return t
 }
@@ -0,0 +1,493 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// Bool is the numeric type of boolean elements, holding 1 for true
+// and 0 for false. Any other nonzero value is also considered true.
+type Bool uint8
+
+// A Mask is a Tensor of booleans, such as the ones returned by
+// comparisons, that can be used to select elements from a Tensor.
+type Mask = Tensor[Bool]
+
+// toBool converts a boolean to its numeric counterpart.
+func toBool(b bool) Bool {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// zipTo returns a new Tensor of the given type holding the results
+// of an elementwise operation between other and this Tensor,
+// both broadcast together.
+func zipTo[T Number, U Number](t Tensor[T], other any, f func(T, T) U) Tensor[U] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return Tensor[U]{
+				Err: t.Err,
+			}
+		}
+	}
+
+	o, ok := other.(Tensor[T])
+	if !ok {
+		o = From[T](other)
+	}
+
+	if o.Err != nil {
+		if EnvConfig.Interactive {
+			panic(o.Err)
+		} else {
+			return Tensor[U]{
+				Err: o.Err,
+			}
+		}
+	}
+
+	shape, ok := broadcastShapes(t.shape, o.shape)
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			return Tensor[U]{
+				Err: ErrNotBroadable,
+			}
+		}
+	}
+
+	if len(shape) == 0 {
+		shape = nil
+	}
+
+	size := 1
+	for _, d := range shape {
+		size *= d
+	}
+
+	data := slices.WithLen[U](size)
+
+	strides := [][]int{configStride(shape), t.broadcastStride(shape), o.broadcastStride(shape)}
+	handleLayouts(shape, strides, []int{0, t.offset, o.offset}, func(pos []int) {
+		data[pos[0]] = f(t.data[pos[1]], o.data[pos[2]])
+	}, configCPU(size))
+
+	return Tensor[U]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Eq takes a value and returns a Mask of whether each element
+// of this Tensor is equal to the corresponding element of other.
+func (t Tensor[T]) Eq(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x == y)
+	})
+}
+
+// Ne takes a value and returns a Mask of whether each element
+// of this Tensor is not equal to the corresponding element of other.
+func (t Tensor[T]) Ne(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x != y)
+	})
+}
+
+// Lt takes a value and returns a Mask of whether each element
+// of this Tensor is less than the corresponding element of other.
+func (t Tensor[T]) Lt(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x < y)
+	})
+}
+
+// Le takes a value and returns a Mask of whether each element of this
+// Tensor is less than or equal to the corresponding element of other.
+func (t Tensor[T]) Le(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x <= y)
+	})
+}
+
+// Gt takes a value and returns a Mask of whether each element
+// of this Tensor is greater than the corresponding element of other.
+func (t Tensor[T]) Gt(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x > y)
+	})
+}
+
+// Ge takes a value and returns a Mask of whether each element of this
+// Tensor is greater than or equal to the corresponding element of other.
+func (t Tensor[T]) Ge(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x >= y)
+	})
+}
+
+// And takes a value and returns the elementwise logical conjunction
+// of other and this Tensor, whose nonzero elements are considered true.
+func (t Tensor[T]) And(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x != 0 && y != 0)
+	})
+}
+
+// Or takes a value and returns the elementwise logical disjunction
+// of other and this Tensor, whose nonzero elements are considered true.
+func (t Tensor[T]) Or(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool(x != 0 || y != 0)
+	})
+}
+
+// Xor takes a value and returns the elementwise exclusive disjunction
+// of other and this Tensor, whose nonzero elements are considered true.
+func (t Tensor[T]) Xor(other any) Mask {
+	return zipTo(t, other, func(x, y T) Bool {
+		return toBool((x != 0) != (y != 0))
+	})
+}
+
+// Not returns the elementwise logical negation of
+// the Tensor, whose nonzero elements are considered true.
+func (t Tensor[T]) Not() Mask {
+	var zero T
+	return zipTo(t, Tensor[T]{data: []T{zero}}, func(x, _ T) Bool {
+		return toBool(x == 0)
+	})
+}
+
+// anyReducer returns a Reducer of whether any element is nonzero.
+func anyReducer[T Number]() Reducer[T, bool] {
+	return Reducer[T, bool]{
+		Identity: func() bool { return false },
+		Step:     func(acc bool, x T) bool { return acc || x != 0 },
+		Combine:  func(x, y bool) bool { return x || y },
+	}
+}
+
+// allReducer returns a Reducer of whether all elements are nonzero.
+func allReducer[T Number]() Reducer[T, bool] {
+	return Reducer[T, bool]{
+		Identity: func() bool { return true },
+		Step:     func(acc bool, x T) bool { return acc && x != 0 },
+		Combine:  func(x, y bool) bool { return x && y },
+	}
+}
+
+// countReducer returns a Reducer of the number of nonzero elements.
+func countReducer[T Number]() Reducer[T, int] {
+	return Reducer[T, int]{
+		Identity: func() int { return 0 },
+		Chunk: func(acc int, s []T) int {
+			for _, x := range s {
+				if x != 0 {
+					acc++
+				}
+			}
+			return acc
+		},
+		Combine: func(x, y int) int { return x + y },
+	}
+}
+
+// Any returns a rank 0 Mask of whether any of the Tensor's elements is nonzero.
+func (t Tensor[T]) Any() Mask {
+	return t.AnyAxis(false)
+}
+
+// All returns a rank 0 Mask of whether all of the Tensor's elements are nonzero.
+func (t Tensor[T]) All() Mask {
+	return t.AllAxis(false)
+}
+
+// CountNonzero returns the number of nonzero elements in the Tensor.
+func (t Tensor[T]) CountNonzero() Tensor[int] {
+	return t.CountNonzeroAxis(false)
+}
+
+// AnyAxis returns a Mask of whether any of the Tensor's elements is nonzero
+// along the given axes.
+func (t Tensor[T]) AnyAxis(keepdim bool, axes ...int) Mask {
+	return foldAxis("AnyAxis", t, anyReducer[T](), toBool, keepdim, axes...)
+}
+
+// AllAxis returns a Mask of whether all of the Tensor's elements are nonzero
+// along the given axes.
+func (t Tensor[T]) AllAxis(keepdim bool, axes ...int) Mask {
+	return foldAxis("AllAxis", t, allReducer[T](), toBool, keepdim, axes...)
+}
+
+// CountNonzeroAxis returns the number of nonzero elements in the Tensor
+// along the given axes.
+func (t Tensor[T]) CountNonzeroAxis(keepdim bool, axes ...int) Tensor[int] {
+	return foldAxis("CountNonzeroAxis", t, countReducer[T](), func(n int) int { return n }, keepdim, axes...)
+}
+
+// asMask returns the given condition, either a Mask or a Tensor of
+// any numeric type, as a Mask of whether its elements are nonzero.
+func asMask(cond any) (Mask, error) {
+	if mask, ok := cond.(Mask); ok {
+		return mask, mask.Err
+	}
+
+	c, ok := anyToTensor[float64](cond)
+	if !ok {
+		c = From[float64](cond)
+	}
+
+	if c.Err != nil {
+		return Mask{}, c.Err
+	}
+
+	mask := c.Ne(0)
+	return mask, mask.Err
+}
+
+// Where returns a new Tensor made of the elements of a where the condition
+// is nonzero, and of the elements of b elsewhere, all three operands being
+// broadcast together.
+func Where[T Number, C Number](cond Tensor[C], a, b Tensor[T]) Tensor[T] {
+	for _, err := range []error{cond.Err, a.Err, b.Err} {
+		if err != nil {
+			if EnvConfig.Interactive {
+				panic(err)
+			} else {
+				return Tensor[T]{
+					Err: err,
+				}
+			}
+		}
+	}
+
+	shape, ok := broadcastShapes(cond.shape, a.shape)
+	if ok {
+		shape, ok = broadcastShapes(shape, b.shape)
+	}
+
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			return Tensor[T]{
+				Err: ErrNotBroadable,
+			}
+		}
+	}
+
+	if len(shape) == 0 {
+		shape = nil
+	}
+
+	size := 1
+	for _, d := range shape {
+		size *= d
+	}
+
+	data := slices.WithLen[T](size)
+
+	strides := [][]int{configStride(shape), cond.broadcastStride(shape), a.broadcastStride(shape), b.broadcastStride(shape)}
+	handleLayouts(shape, strides, []int{0, cond.offset, a.offset, b.offset}, func(pos []int) {
+		if cond.data[pos[1]] != 0 {
+			data[pos[0]] = a.data[pos[2]]
+		} else {
+			data[pos[0]] = b.data[pos[3]]
+		}
+	}, configCPU(size))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// MaskedSelect returns a new rank 1 Tensor made of the Tensor's elements
+// where the condition is nonzero, in row-major order, the Tensor and the
+// condition being broadcast together. The condition is either a Mask or
+// a Tensor of any numeric type, such as a Tensor of 0s and 1s.
+// It fails with ErrNoElements if the condition is zero everywhere.
+func (t Tensor[T]) MaskedSelect(cond any) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	mask, err := asMask(cond)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape, ok := broadcastShapes(t.shape, mask.shape)
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			t.Err = ErrNotBroadable
+			return t
+		}
+	}
+
+	size := 1
+	for _, d := range shape {
+		size *= d
+	}
+
+	var data []T
+
+	strides := [][]int{t.broadcastStride(shape), mask.broadcastStride(shape)}
+	walkLayouts(shape, strides, []int{t.offset, mask.offset}, 0, size, func(pos []int) {
+		if mask.data[pos[1]] != 0 {
+			data = append(data, t.data[pos[0]])
+		}
+	})
+
+	if len(data) == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrNoElements)
+		} else {
+			t.Err = ErrNoElements
+			return t
+		}
+	}
+
+	return Tensor[T]{
+		data:   data,
+		shape:  []int{len(data)},
+		stride: []int{1},
+	}
+}
+
+// MaskedFill sets the Tensor's elements to the given value where the
+// condition, which must be broadcastable to the Tensor's shape, is nonzero.
+// The condition is either a Mask or a Tensor of any numeric type.
+func (t Tensor[T]) MaskedFill(cond any, value T) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	mask, err := asMask(cond)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	shape, ok := broadcastShapes(t.shape, mask.shape)
+	if !ok || !slices.Equal(shape, t.shape) {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			t.Err = ErrNotBroadable
+			return t
+		}
+	}
+
+	strides := [][]int{t.stride, mask.broadcastStride(t.shape)}
+	handleLayouts(t.shape, strides, []int{t.offset, mask.offset}, func(pos []int) {
+		if mask.data[pos[1]] != 0 {
+			t.data[pos[0]] = value
+		}
+	}, configCPU(t.Numel()))
+
+	return t
+}
+
+// Nonzero returns the coordinates of the Tensor's nonzero elements
+// in row-major order, as a Tensor of shape (k, rank) for k such elements.
+// It fails with ErrBadShape for a rank 0 Tensor, which has no coordinates,
+// and with ErrNoElements if all of the Tensor's elements are zero.
+func (t Tensor[T]) Nonzero() Tensor[int] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return Tensor[int]{
+				Err: t.Err,
+			}
+		}
+	}
+
+	if len(t.shape) == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrBadShape)
+		} else {
+			return Tensor[int]{
+				Err: ErrBadShape,
+			}
+		}
+	}
+
+	var data []int
+	k := 0
+
+	coords := slices.WithLen[int](len(t.shape))
+	walkLayout(t.shape, t.stride, t.offset, 0, t.Numel(), func(pos int) {
+		if t.data[pos] != 0 {
+			data = append(data, coords...)
+			k++
+		}
+
+		for i := len(coords) - 1; i >= 0; i-- {
+			coords[i]++
+			if coords[i] < t.shape[i] {
+				break
+			}
+			coords[i] = 0
+		}
+	})
+
+	if k == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrNoElements)
+		} else {
+			return Tensor[int]{
+				Err: ErrNoElements,
+			}
+		}
+	}
+
+	shape := []int{k, len(t.shape)}
+
+	return Tensor[int]{
+		data:   data,
+		shape:  shape,
+		stride: []int{len(t.shape), 1},
+	}
+}
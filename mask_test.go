@@ -0,0 +1,186 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestCompare(t *testing.T) {
+	tensor := nune.Range[float64](0, 6, 1).Reshape(2, 3)
+
+	gt := tensor.Gt(2.5)
+	if !slices.Equal(gt.Ravel(), []nune.Bool{0, 0, 0, 1, 1, 1}) {
+		t.Error("comparison with a scalar is incorrect")
+	}
+
+	// comparing with a broadcast row
+	eq := tensor.Eq(nune.FromBuffer([]float64{0, 4, 5}))
+	if !slices.Equal(eq.Ravel(), []nune.Bool{1, 0, 0, 0, 1, 1}) {
+		t.Error("comparison with a broadcast row is incorrect")
+	}
+
+	if !slices.Equal(eq.Shape(), []int{2, 3}) {
+		t.Error("comparison with a broadcast row has the wrong shape")
+	}
+
+	// comparing a strided view with a broadcast column
+	le := tensor.Permute(1, 0).Le(nune.FromBuffer([]float64{0, 1, 5}).Reshape(3, 1))
+	if !slices.Equal(le.Ravel(), []nune.Bool{1, 0, 1, 0, 1, 1}) {
+		t.Error("comparison of a view is incorrect")
+	}
+
+	if tensor.Lt(nune.Zeros[float64](4)).Err == nil {
+		t.Error("compared tensors of incompatible shapes")
+	}
+}
+
+func TestLogical(t *testing.T) {
+	x := nune.FromBuffer([]int{0, 1, 2, 0})
+	y := nune.FromBuffer([]int{0, 3, 0, 4})
+
+	if !slices.Equal(x.And(y).Ravel(), []nune.Bool{0, 1, 0, 0}) {
+		t.Error("logical and is incorrect")
+	}
+
+	if !slices.Equal(x.Or(y).Ravel(), []nune.Bool{0, 1, 1, 1}) {
+		t.Error("logical or is incorrect")
+	}
+
+	if !slices.Equal(x.Xor(y).Ravel(), []nune.Bool{0, 0, 1, 1}) {
+		t.Error("logical xor is incorrect")
+	}
+
+	if !slices.Equal(x.Not().Ravel(), []nune.Bool{1, 0, 0, 1}) {
+		t.Error("logical not is incorrect")
+	}
+
+	// masks combine with each other
+	mask := x.Gt(0).And(y.Gt(0))
+	if !slices.Equal(mask.Ravel(), []nune.Bool{0, 1, 0, 0}) {
+		t.Error("logical and of masks is incorrect")
+	}
+}
+
+func TestAnyAll(t *testing.T) {
+	tensor := nune.FromBuffer([]int{0, 1, 0, 2, 3, 4}).Reshape(2, 3)
+
+	if tensor.Any().Scalar() != 1 || tensor.All().Scalar() != 0 {
+		t.Error("any or all over the whole tensor is incorrect")
+	}
+
+	if !slices.Equal(tensor.AllAxis(false, 1).Ravel(), []nune.Bool{0, 1}) {
+		t.Error("all along axis 1 is incorrect")
+	}
+
+	if !slices.Equal(tensor.AnyAxis(false, 0).Ravel(), []nune.Bool{1, 1, 1}) {
+		t.Error("any along axis 0 is incorrect")
+	}
+
+	if tensor.CountNonzero().Scalar() != 4 {
+		t.Error("count of nonzero elements is incorrect")
+	}
+
+	if !slices.Equal(tensor.CountNonzeroAxis(true, 1).Ravel(), []int{1, 3}) {
+		t.Error("count of nonzero elements along axis 1 is incorrect")
+	}
+}
+
+func TestWhere(t *testing.T) {
+	cond := nune.FromBuffer([]int{1, 0, 1}).Reshape(1, 3)
+	a := nune.Range[float64](0, 6, 1).Reshape(2, 3)
+	b := nune.FromBuffer([]float64{-1, -2}).Reshape(2, 1)
+
+	out := nune.Where(cond, a, b)
+	if !slices.Equal(out.Ravel(), []float64{0, -1, 2, 3, -2, 5}) {
+		t.Error("where with broadcast operands is incorrect")
+	}
+
+	// selecting with a mask from a strided view
+	view := a.Permute(1, 0)
+	out = nune.Where(view.Gt(2), view, nune.Zeros[float64](1))
+	if !slices.Equal(out.Ravel(), []float64{0, 3, 0, 4, 0, 5}) {
+		t.Error("where on a view is incorrect")
+	}
+}
+
+func TestMaskedSelect(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	out := tensor.MaskedSelect(tensor.Ge(4).Or(tensor.Eq(1)))
+	if !slices.Equal(out.Ravel(), []int{1, 4, 5}) || !slices.Equal(out.Shape(), []int{3}) {
+		t.Error("masked select is incorrect")
+	}
+
+	// the mask is broadcast along the rows
+	out = tensor.Permute(1, 0).MaskedSelect(nune.FromBuffer([]nune.Bool{0, 1}))
+	if !slices.Equal(out.Ravel(), []int{3, 4, 5}) {
+		t.Error("masked select with a broadcast mask is incorrect")
+	}
+
+	out = tensor.MaskedSelect(nune.FromBuffer([]int{1, 0, 0, 0, 2, 0}).Reshape(2, 3))
+	if !slices.Equal(out.Ravel(), []int{0, 4}) {
+		t.Error("masked select with an int condition is incorrect")
+	}
+
+	out = tensor.MaskedSelect(nune.FromBuffer([]float64{0, 0.5, 0}))
+	if !slices.Equal(out.Ravel(), []int{1, 4}) {
+		t.Error("masked select with a fractional condition is incorrect")
+	}
+
+	if !errors.Is(tensor.MaskedSelect(tensor.Gt(5)).Err, nune.ErrNoElements) {
+		t.Error("masked select with an all-zero mask does not report it")
+	}
+}
+
+func TestMaskedFill(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	tensor.Permute(1, 0).MaskedFill(nune.FromBuffer([]nune.Bool{1, 0, 1}).Reshape(3, 1), -1)
+	if !slices.Equal(tensor.Ravel(), []int{-1, 1, -1, -1, 4, -1}) {
+		t.Error("masked fill through a view is incorrect")
+	}
+
+	tensor.MaskedFill(nune.FromBuffer([]int{0, 1}).Reshape(2, 1), 9)
+	if !slices.Equal(tensor.Ravel(), []int{-1, 1, -1, 9, 9, 9}) {
+		t.Error("masked fill with an int condition is incorrect")
+	}
+
+	if tensor.MaskedFill(nune.Zeros[nune.Bool](2, 2, 3), 0).Err == nil {
+		t.Error("filled with a mask that does not broadcast to the tensor")
+	}
+}
+
+func TestNonzero(t *testing.T) {
+	tensor := nune.FromBuffer([]int{0, 2, 0, 3, 0, 4}).Reshape(2, 3)
+
+	out := tensor.Nonzero()
+	if !slices.Equal(out.Ravel(), []int{0, 1, 1, 0, 1, 2}) || !slices.Equal(out.Shape(), []int{3, 2}) {
+		t.Error("nonzero coordinates are incorrect")
+	}
+
+	out = tensor.Permute(1, 0).Nonzero()
+	if !slices.Equal(out.Ravel(), []int{0, 1, 1, 0, 2, 1}) {
+		t.Error("nonzero coordinates of a view are incorrect")
+	}
+
+	if !errors.Is(nune.Zeros[int](2, 3).Nonzero().Err, nune.ErrNoElements) {
+		t.Error("nonzero of an all-zero tensor does not report it")
+	}
+
+	if !errors.Is(nune.From[int](1).Nonzero().Err, nune.ErrBadShape) {
+		t.Error("nonzero of a rank 0 tensor")
+	}
+}
+
+func BenchmarkGt(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Gt(5e6)
+	})
+}
@@ -0,0 +1,319 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"sync"
+
+	"github.com/vorduin/slices"
+)
+
+// Block sizes of the matrix multiplication kernel, chosen so
+// that a packed panel of the right-hand side fits in cache.
+const (
+	blockK = 128
+	blockN = 256
+)
+
+// A matrix describes a strided 2-dimensional view
+// within a Tensor's data buffer.
+type matrix struct {
+	rows, cols       int
+	rowStep, colStep int
+}
+
+// handleMatMulRows computes the rows [min, max) of the product of the lhs
+// and rhs matrices found at the given offsets, and adds them to the
+// contiguous out matrix found at outOffset.
+func handleMatMulRows[T Number](lhs, rhs []T, out []T, a, b matrix, lhsOffset, rhsOffset, outOffset, min, max int, panel []T) {
+	n := b.cols
+
+	for jj := 0; jj < n; jj += blockN {
+		nc := blockN
+		if jj+nc > n {
+			nc = n - jj
+		}
+
+		for kk := 0; kk < a.cols; kk += blockK {
+			kc := blockK
+			if kk+kc > a.cols {
+				kc = a.cols - kk
+			}
+
+			// pack the rhs block contiguously so that the inner loop
+			// streams through memory whatever the rhs layout
+			for p := 0; p < kc; p++ {
+				row := rhsOffset + (kk+p)*b.rowStep + jj*b.colStep
+				for j := 0; j < nc; j++ {
+					panel[p*nc+j] = rhs[row+j*b.colStep]
+				}
+			}
+
+			for i := min; i < max; i++ {
+				outRow := out[outOffset+i*n+jj : outOffset+i*n+jj+nc]
+				lhsRow := lhsOffset + i*a.rowStep + kk*a.colStep
+
+				for p := 0; p < kc; p++ {
+					x := lhs[lhsRow+p*a.colStep]
+					rhsRow := panel[p*nc : p*nc+nc]
+					for j, y := range rhsRow {
+						outRow[j] += x * y
+					}
+				}
+			}
+		}
+	}
+}
+
+// handleMatMul processes a batched matrix multiplication accordingly,
+// splitting the rows of all the batches' output matrices across goroutines.
+func handleMatMul[T Number](lhs, rhs Tensor[T], out []T, a, b matrix, lhsOffsets, rhsOffsets []int, nCPU int) {
+	m, n := a.rows, b.cols
+	rows := len(lhsOffsets) * m
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < nCPU; i++ {
+		min := (i * rows / nCPU)
+		max := ((i + 1) * rows) / nCPU
+
+		wg.Add(1)
+		go func(min, max int) {
+			panel := slices.WithLen[T](blockK * blockN)
+
+			for r := min; r < max; {
+				batch := r / m
+				end := (batch + 1) * m
+				if end > max {
+					end = max
+				}
+
+				handleMatMulRows(lhs.data, rhs.data, out, a, b, lhsOffsets[batch], rhsOffsets[batch],
+					batch*m*n, r-batch*m, end-batch*m, panel)
+
+				r = end
+			}
+
+			wg.Done()
+		}(min, max)
+	}
+
+	wg.Wait()
+}
+
+// batchOffsets returns the data buffer offsets of each matrix of the Tensor,
+// whose leading axes are broadcast to the given batch shape.
+func (t Tensor[T]) batchOffsets(batch []int) []int {
+	lead := t.shape[:len(t.shape)-2]
+
+	stride := slices.WithLen[int](len(batch))
+	for i := range lead {
+		if lead[i] != 1 {
+			stride[len(batch)-len(lead)+i] = t.stride[i]
+		}
+	}
+
+	offsets := make([]int, 0, slices.Prod(append([]int{1}, batch...)))
+	walkLayout(batch, stride, t.offset, 0, cap(offsets), func(pos int) {
+		offsets = append(offsets, pos)
+	})
+
+	return offsets
+}
+
+// MatMul returns the matrix product of this and the other Tensor.
+// Rank 2 Tensors are multiplied as matrices, while Tensors of higher rank
+// are treated as batches of matrices stored in their last two axes,
+// whose leading axes are broadcast together.
+// A rank 1 Tensor on the left or on the right is treated as a row
+// or a column vector respectively, and the added axis is removed
+// from the result.
+// Strided views, such as the ones returned by Permute,
+// are multiplied directly without being copied.
+func (t Tensor[T]) MatMul(other Tensor[T]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if other.Err != nil {
+		if EnvConfig.Interactive {
+			panic(other.Err)
+		} else {
+			t.Err = other.Err
+			return t
+		}
+	}
+
+	if t.Rank() == 0 || other.Rank() == 0 {
+		if EnvConfig.Interactive {
+			panic(ErrBadShape)
+		} else {
+			t.Err = ErrBadShape
+			return t
+		}
+	}
+
+	lhs, rhs := t, other
+	if lhs.Rank() == 1 {
+		lhs = lhs.Unsqueeze(0)
+	}
+	if rhs.Rank() == 1 {
+		rhs = rhs.Unsqueeze(1)
+	}
+
+	a := matrix{
+		rows:    lhs.shape[lhs.Rank()-2],
+		cols:    lhs.shape[lhs.Rank()-1],
+		rowStep: lhs.stride[lhs.Rank()-2],
+		colStep: lhs.stride[lhs.Rank()-1],
+	}
+	b := matrix{
+		rows:    rhs.shape[rhs.Rank()-2],
+		cols:    rhs.shape[rhs.Rank()-1],
+		rowStep: rhs.stride[rhs.Rank()-2],
+		colStep: rhs.stride[rhs.Rank()-1],
+	}
+
+	if a.cols != b.rows {
+		if EnvConfig.Interactive {
+			panic(ErrShapeMismatch)
+		} else {
+			t.Err = ErrShapeMismatch
+			return t
+		}
+	}
+
+	batch, ok := broadcastShapes(lhs.shape[:lhs.Rank()-2], rhs.shape[:rhs.Rank()-2])
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			t.Err = ErrNotBroadable
+			return t
+		}
+	}
+
+	lhsOffsets := lhs.batchOffsets(batch)
+	rhsOffsets := rhs.batchOffsets(batch)
+
+	out := slices.WithLen[T](len(lhsOffsets) * a.rows * b.cols)
+	nCPU := configCPU(len(out) * a.cols)
+	if nCPU > len(lhsOffsets)*a.rows {
+		nCPU = len(lhsOffsets) * a.rows
+	}
+
+	handleMatMul(lhs, rhs, out, a, b, lhsOffsets, rhsOffsets, nCPU)
+
+	shape := batch
+	if t.Rank() > 1 {
+		shape = append(shape, a.rows)
+	}
+	if other.Rank() > 1 {
+		shape = append(shape, b.cols)
+	}
+	if len(shape) == 0 {
+		shape = nil
+	}
+
+	return Tensor[T]{
+		data:   out,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Dot returns the dot product of this and the other rank 1 Tensor.
+func (t Tensor[T]) Dot(other Tensor[T]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if other.Err != nil {
+		if EnvConfig.Interactive {
+			panic(other.Err)
+		} else {
+			t.Err = other.Err
+			return t
+		}
+	}
+
+	if t.Rank() != 1 || other.Rank() != 1 {
+		if EnvConfig.Interactive {
+			panic(ErrShapeMismatch)
+		} else {
+			t.Err = ErrShapeMismatch
+			return t
+		}
+	}
+
+	return t.MatMul(other)
+}
+
+// Outer returns the outer product of this and the other Tensor,
+// both of which are flattened if they aren't of rank 1.
+func (t Tensor[T]) Outer(other Tensor[T]) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	if other.Err != nil {
+		if EnvConfig.Interactive {
+			panic(other.Err)
+		} else {
+			t.Err = other.Err
+			return t
+		}
+	}
+
+	lhs := t.gather(0, t.Numel(), nil)
+	rhs := other.gather(0, other.Numel(), nil)
+
+	out := slices.WithLen[T](len(lhs) * len(rhs))
+	nCPU := configCPU(len(out))
+	if nCPU > len(lhs) {
+		nCPU = len(lhs)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < nCPU; i++ {
+		min := (i * len(lhs) / nCPU)
+		max := ((i + 1) * len(lhs)) / nCPU
+
+		wg.Add(1)
+		go func(min, max int) {
+			for j := min; j < max; j++ {
+				row := out[j*len(rhs) : (j+1)*len(rhs)]
+				for k, y := range rhs {
+					row[k] = lhs[j] * y
+				}
+			}
+
+			wg.Done()
+		}(min, max)
+	}
+
+	wg.Wait()
+
+	shape := []int{len(lhs), len(rhs)}
+
+	return Tensor[T]{
+		data:   out,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestMatMul(t *testing.T) {
+	lhs := nune.Range[int](0, 6, 1).Reshape(2, 3)
+	rhs := nune.Range[int](0, 6, 1).Reshape(3, 2)
+
+	res := lhs.MatMul(rhs)
+	if !slices.Equal(res.Ravel(), []int{10, 13, 28, 40}) {
+		t.Error("matrix product is incorrect")
+	}
+
+	if !slices.Equal(res.Shape(), []int{2, 2}) {
+		t.Error("matrix product has the wrong shape")
+	}
+
+	// lhs times its own transpose, without copying it
+	res = lhs.MatMul(lhs.Permute(1, 0))
+	if !slices.Equal(res.Ravel(), []int{5, 14, 14, 50}) {
+		t.Error("matrix product with a transposed view is incorrect")
+	}
+
+	res = lhs.MatMul(nune.From[int]([]int{1, 1, 1}))
+	if !slices.Equal(res.Ravel(), []int{3, 12}) || !slices.Equal(res.Shape(), []int{2}) {
+		t.Error("matrix-vector product is incorrect")
+	}
+
+	if lhs.MatMul(lhs).Err == nil {
+		t.Error("multiplied matrices with mismatching inner dimensions")
+	}
+}
+
+func TestMatMulBatched(t *testing.T) {
+	lhs := nune.Range[int](0, 12, 1).Reshape(2, 1, 2, 3)
+	rhs := nune.Range[int](0, 18, 1).Reshape(3, 3, 2)
+
+	res := lhs.MatMul(rhs)
+	if !slices.Equal(res.Shape(), []int{2, 3, 2, 2}) {
+		t.Fatal("batched matrix product has the wrong shape")
+	}
+
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			expected := lhs.Index(i, 0).MatMul(rhs.Index(j))
+			if !slices.Equal(res.Index(i, j).Ravel(), expected.Ravel()) {
+				t.Errorf("batch (%d, %d) of the matrix product is incorrect", i, j)
+			}
+		}
+	}
+
+	if lhs.MatMul(nune.Zeros[int](4, 3, 3, 2)).Err == nil {
+		t.Error("multiplied batches that are not broadcastable")
+	}
+}
+
+func TestDotOuter(t *testing.T) {
+	x := nune.From[int]([]int{1, 2, 3})
+	y := nune.From[int]([]int{4, 5})
+
+	if x.Dot(x).Scalar() != 14 {
+		t.Error("dot product is incorrect")
+	}
+
+	if x.Dot(y).Err == nil {
+		t.Error("computed the dot product of vectors of different lengths")
+	}
+
+	outer := x.Outer(y)
+	if !slices.Equal(outer.Ravel(), []int{4, 5, 8, 10, 12, 15}) || !slices.Equal(outer.Shape(), []int{3, 2}) {
+		t.Error("outer product is incorrect")
+	}
+}
+
+func BenchmarkMatMul(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		m := tensor.Slice(0, 250000).Reshape(500, 500)
+		m.MatMul(m.Permute(1, 0))
+	})
+}
@@ -62,3 +62,17 @@ func benchmarkOp(b *testing.B, f func(nune.Tensor[TestsT])) {
 		})
 	})
 }
+
+// elements returns the elements of a Tensor, which might
+// be a strided view, in row-major order.
+func elements[T nune.Number](tensor nune.Tensor[T]) []T {
+	it := tensor.Iter()
+
+	s := make([]T, it.Size())
+	for i := range s {
+		e, _ := it.Next()
+		s[i] = e.Scalar()
+	}
+
+	return s
+}
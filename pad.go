@@ -0,0 +1,147 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"math"
+
+	"github.com/vorduin/slices"
+)
+
+// PadMode is the method used to fill the
+// elements a padding adds around a Tensor.
+type PadMode int
+
+// List of padding modes, illustrated for an axis
+// holding 1 2 3 padded by 2 on both sides.
+const (
+	PadConstant  PadMode = iota // v v | 1 2 3 | v v
+	PadReflect                  // 3 2 | 1 2 3 | 2 1
+	PadSymmetric                // 2 1 | 1 2 3 | 3 2
+	PadEdge                     // 1 1 | 1 2 3 | 3 3
+	PadCircular                 // 2 3 | 1 2 3 | 1 2
+
+	PadReplicate = PadEdge
+)
+
+// padFill marks the entries of a padding
+// filled with the constant value.
+const padFill = math.MinInt
+
+// padIndex returns the index, within an axis of the given
+// dimensions, of the element the padding mode places at the given
+// index, which might lie outside the axis. It returns -1 if the
+// element is the constant value instead.
+func padIndex(i, size int, mode PadMode) int {
+	if i >= 0 && i < size {
+		return i
+	}
+
+	switch mode {
+	case PadReflect:
+		if size == 1 {
+			return 0
+		}
+		period := 2 * (size - 1)
+		i = ((i % period) + period) % period
+		if i >= size {
+			i = period - i
+		}
+		return i
+	case PadSymmetric:
+		period := 2 * size
+		i = ((i % period) + period) % period
+		if i >= size {
+			i = period - 1 - i
+		}
+		return i
+	case PadEdge:
+		if i < 0 {
+			return 0
+		}
+		return size - 1
+	case PadCircular:
+		return ((i % size) + size) % size
+	default:
+		return -1
+	}
+}
+
+// Pad returns a new Tensor made of the Tensor padded along its axes,
+// each pair of widths holding the number of elements added before and
+// after an axis. If widths holds fewer pairs than the Tensor's rank,
+// they pad its last axes. The added elements are filled according to
+// the given mode, the constant value being used by PadConstant only.
+// Paddings wider than an axis keep reflecting or wrapping around it.
+func (t Tensor[T]) Pad(widths [][2]int, mode PadMode, value T) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	err := verifyGoodPadding(t.shape, widths, mode)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	rank := len(t.shape)
+	shape := slices.Clone(t.shape)
+
+	// positions holds, for each axis, the data buffer position offset
+	// of every entry of the result, or padFill for a constant, since
+	// offsets are negative along axes with a negative stride
+	positions := make([][]int, rank)
+	strides := make([][]int, rank+1)
+
+	for i := range t.shape {
+		before, after := 0, 0
+		if j := i - rank + len(widths); j >= 0 {
+			before, after = widths[j][0], widths[j][1]
+		}
+
+		shape[i] += before + after
+		positions[i] = make([]int, shape[i])
+		for k := range positions[i] {
+			positions[i][k] = padFill
+			if idx := padIndex(k-before, t.shape[i], mode); idx >= 0 {
+				positions[i][k] = idx * t.stride[i]
+			}
+		}
+
+		// walking this layout yields the entry's index along the axis
+		strides[i+1] = make([]int, rank)
+		strides[i+1][i] = 1
+	}
+
+	strides[0] = configStride(shape)
+	data := slices.WithLen[T](slices.Prod(shape))
+
+	handleLayouts(shape, strides, make([]int, rank+1), func(pos []int) {
+		src := t.offset
+		for i, k := range pos[1:] {
+			if positions[i][k] == padFill {
+				data[pos[0]] = value
+				return
+			}
+			src += positions[i][k]
+		}
+
+		data[pos[0]] = t.data[src]
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
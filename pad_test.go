@@ -0,0 +1,76 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestPad(t *testing.T) {
+	vector := nune.Range[int](1, 4, 1)
+	widths := [][2]int{{2, 2}}
+
+	cases := []struct {
+		mode nune.PadMode
+		want []int
+	}{
+		{nune.PadConstant, []int{9, 9, 1, 2, 3, 9, 9}},
+		{nune.PadReflect, []int{3, 2, 1, 2, 3, 2, 1}},
+		{nune.PadSymmetric, []int{2, 1, 1, 2, 3, 3, 2}},
+		{nune.PadReplicate, []int{1, 1, 1, 2, 3, 3, 3}},
+		{nune.PadCircular, []int{2, 3, 1, 2, 3, 1, 2}},
+	}
+
+	for _, c := range cases {
+		if got := vector.Pad(widths, c.mode, 9).Ravel(); !slices.Equal(got, c.want) {
+			t.Errorf("padding of mode %d is %v, want %v", c.mode, got, c.want)
+		}
+	}
+
+	if !slices.Equal(vector.Pad([][2]int{{5, 0}}, nune.PadCircular, 0).Ravel(), []int{2, 3, 1, 2, 3, 1, 2, 3}) {
+		t.Error("circular padding wider than the axis is incorrect")
+	}
+
+	matrix := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	padded := matrix.Pad([][2]int{{1, 0}, {0, 1}}, nune.PadConstant, -1)
+	if !slices.Equal(padded.Shape(), []int{3, 4}) || !slices.Equal(padded.Ravel(), []int{-1, -1, -1, -1, 0, 1, 2, -1, 3, 4, 5, -1}) {
+		t.Error("constant padding of a matrix is incorrect")
+	}
+
+	padded = matrix.T().Pad([][2]int{{0, 1}}, nune.PadEdge, 0)
+	if !slices.Equal(padded.Shape(), []int{3, 3}) || !slices.Equal(padded.Ravel(), []int{0, 3, 3, 1, 4, 4, 2, 5, 5}) {
+		t.Error("padding of the last axis of a view is incorrect")
+	}
+
+	reversed := vector.Get(nune.S(nune.None, nune.None, -1))
+	if !slices.Equal(reversed.Pad(widths[:1], nune.PadEdge, 0).Ravel(), []int{3, 3, 3, 2, 1, 1, 1}) {
+		t.Error("padding of a view with a negative stride is incorrect")
+	}
+
+	rotated := matrix.Rot90(1, [2]int{0, 1})
+	padded = rotated.Pad([][2]int{{1, 0}, {0, 1}}, nune.PadEdge, 0)
+	if !slices.Equal(padded.Ravel(), []int{2, 5, 5, 2, 5, 5, 1, 4, 4, 0, 3, 3}) {
+		t.Error("padding of a rotated view is incorrect")
+	}
+
+	if !errors.Is(matrix.Pad([][2]int{{0, 0}, {0, 0}, {0, 0}}, nune.PadConstant, 0).Err, nune.ErrBadPadding) {
+		t.Error("padded more axes than the tensor has")
+	}
+
+	if !errors.Is(matrix.Pad([][2]int{{-1, 0}}, nune.PadConstant, 0).Err, nune.ErrBadPadding) {
+		t.Error("padded with a negative width")
+	}
+}
+
+func BenchmarkPad(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Pad([][2]int{{8, 8}}, nune.PadReflect, 0)
+	})
+}
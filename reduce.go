@@ -4,103 +4,486 @@
 
 package nune
 
-// handleReduce processes a slice reduction operation accordingly.
-func handleReduce[T Number](in []T, out *T, f func([]T) T, nCPU int) {
-	outBuf := make([]T, 0, nCPU)
-	ch := make(chan T, nCPU)
+import (
+	"sync"
+
+	"github.com/vorduin/slices"
+)
+
+// A Reducer describes a reduction of elements of type T into
+// an accumulator of type A, which may differ from T.
+// The reduction might be split into chunks processed by multiple
+// goroutines if the Tensor is big enough, in which case each chunk
+// starts from a fresh identity accumulator, and the partial accumulators
+// are merged with Combine in the order of their chunks.
+type Reducer[T Number, A any] struct {
+	Identity func() A       // returns a fresh identity accumulator
+	Step     func(A, T) A   // folds a single element into an accumulator
+	Chunk    func(A, []T) A // folds a chunk of elements into an accumulator, used instead of Step if not nil
+	Combine  func(A, A) A   // merges two partial accumulators, must be associative
+}
+
+// foldRange folds the elements of the Tensor whose row-major
+// index lies within [start, end) into a fresh accumulator,
+// using buf as scratch space if the Tensor is not contiguous.
+// The buffer may be nil.
+func foldRange[T Number, A any](t Tensor[T], r Reducer[T, A], start, end int, buf []T) A {
+	acc := r.Identity()
+
+	if r.Chunk != nil {
+		return r.Chunk(acc, t.gather(start, end, buf))
+	}
+
+	if isContiguous(t.shape, t.stride) {
+		for _, x := range t.data[t.offset+start : t.offset+end] {
+			acc = r.Step(acc, x)
+		}
+	} else {
+		walkLayout(t.shape, t.stride, t.offset, start, end, func(pos int) {
+			acc = r.Step(acc, t.data[pos])
+		})
+	}
+
+	return acc
+}
+
+// handleFold processes a reduction of the elements of the Tensor whose
+// row-major index lies within [start, end) accordingly.
+func handleFold[T Number, A any](t Tensor[T], r Reducer[T, A], start, end, nCPU int) A {
+	if nCPU <= 1 {
+		return foldRange(t, r, start, end, nil)
+	}
+
+	partials := make([]A, nCPU)
+
+	var wg sync.WaitGroup
 
 	for i := 0; i < nCPU; i++ {
-		min := (i * len(in) / nCPU)
-		max := ((i + 1) * len(in)) / nCPU
+		min := start + (i * (end - start) / nCPU)
+		max := start + ((i+1)*(end-start))/nCPU
+
+		wg.Add(1)
+		go func(i, min, max int) {
+			partials[i] = foldRange(t, r, min, max, nil)
+
+			wg.Done()
+		}(i, min, max)
+	}
+
+	wg.Wait()
 
-		go func(inBuf []T, c chan<- T) {
-			c <- f(inBuf)
-		}(in[min:max], ch)
+	acc := partials[0]
+	for i := 1; i < nCPU; i++ {
+		acc = r.Combine(acc, partials[i])
 	}
 
+	return acc
+}
+
+// handleFoldAxis processes a reduction over consecutive groups of
+// inner elements of the Tensor, storing each group's accumulator in out.
+func handleFoldAxis[T Number, A any](t Tensor[T], r Reducer[T, A], out []A, inner int, nCPU int) {
+	if len(out) < nCPU {
+		// too few groups to keep every goroutine busy,
+		// so parallelize each group's reduction instead
+		for i := range out {
+			out[i] = handleFold(t, r, i*inner, (i+1)*inner, configCPU(inner))
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+
 	for i := 0; i < nCPU; i++ {
-		outBuf = append(outBuf, <-ch)
+		min := (i * len(out) / nCPU)
+		max := ((i + 1) * len(out)) / nCPU
+
+		wg.Add(1)
+		go func(min, max int) {
+			var buf []T
+			if !isContiguous(t.shape, t.stride) {
+				buf = make([]T, inner)
+			}
+
+			for j := min; j < max; j++ {
+				out[j] = foldRange(t, r, j*inner, (j+1)*inner, buf)
+			}
+
+			wg.Done()
+		}(min, max)
 	}
 
-	*out = f(outBuf)
+	wg.Wait()
 }
 
-// Reduce performs a reduction operation over all elements in the Tensor.
-// The reduction operation must be able to generalize and parallelize
-// since the operation might be multi-threaded if the Tensor is big enough,
-// unless explicitely disabled in Nune's environment configuration.
-func (t Tensor[T]) Reduce(f func([]T) T) Tensor[T] {
+// Fold reduces all elements in the Tensor with the given Reducer
+// and returns the resulting accumulator, or the Tensor's error
+// along with a zero accumulator if it holds one.
+func Fold[T Number, A any](t Tensor[T], r Reducer[T, A]) (A, error) {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
 			panic(t.Err)
 		} else {
-			return t
+			var zero A
+			return zero, t.Err
 		}
 	}
 
-	var res T
-	handleReduce(t.Ravel(), &res, f, configCPU(t.Numel()))
+	return handleFold(t, r, 0, t.Numel(), configCPU(t.Numel())), nil
+}
+
+// FoldAxis reduces the Tensor's elements along the given axes with the
+// given Reducer, or along all of its axes if none are given, and maps each
+// resulting accumulator to an element of the returned Tensor with result.
+// If keepdim is true, the reduced axes are kept in the resulting shape with
+// dimensions of 1, so that the result can be broadcast back to the Tensor's shape.
+func FoldAxis[T Number, A any, U Number](t Tensor[T], r Reducer[T, A], result func(A) U, keepdim bool, axes ...int) Tensor[U] {
+	return foldAxis("FoldAxis", t, r, result, keepdim, axes...)
+}
 
-	return Tensor[T]{
-		data: []T{res},
+// foldAxis processes a reduction along the given axes as FoldAxis does,
+// with errors naming the given method.
+func foldAxis[T Number, A any, U Number](method string, t Tensor[T], r Reducer[T, A], result func(A) U, keepdim bool, axes ...int) Tensor[U] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return Tensor[U]{
+				Err: t.Err,
+			}
+		}
+	}
+
+	axes, err := normAxes(method, len(t.shape), axes...)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[U]{
+				Err: err,
+			}
+		}
+	}
+
+	view, shape, inner := t.reductionLayout(keepdim, axes...)
+
+	accs := make([]A, t.Numel()/inner)
+	handleFoldAxis(view, r, accs, inner, configCPU(t.Numel()))
+
+	out := slices.WithLen[U](len(accs))
+	for i, acc := range accs {
+		out[i] = result(acc)
+	}
+
+	return Tensor[U]{
+		data:   out,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// Reduce performs a reduction operation over all elements in the Tensor
+// with the given Reducer, whose accumulator is of the Tensor's type.
+func (t Tensor[T]) Reduce(r Reducer[T, T]) Tensor[T] {
+	return t.ReduceAxis(r, false)
+}
+
+// ReduceAxis performs a reduction operation over the given axes of the Tensor
+// with the given Reducer, or over all of its axes if none are given. If keepdim
+// is true, the reduced axes are kept in the resulting shape with dimensions of 1.
+func (t Tensor[T]) ReduceAxis(r Reducer[T, T], keepdim bool, axes ...int) Tensor[T] {
+	return t.reduceAxis("ReduceAxis", r, keepdim, axes...)
+}
+
+// reduceAxis processes a reduction along the given axes as ReduceAxis does,
+// with errors naming the given method.
+func (t Tensor[T]) reduceAxis(method string, r Reducer[T, T], keepdim bool, axes ...int) Tensor[T] {
+	return foldAxis(method, t, r, func(x T) T { return x }, keepdim, axes...)
+}
+
+// reductionLayout returns a view over the Tensor with the given axes
+// permuted to the end, so that each reduced group of elements is
+// contiguous in row-major order, along with the shape of the reduction's
+// result and the number of elements in each reduced group.
+// If no axis is given, all axes are reduced.
+func (t Tensor[T]) reductionLayout(keepdim bool, axes ...int) (Tensor[T], []int, int) {
+	reduced := make([]bool, len(t.shape))
+	if len(axes) == 0 {
+		for i := range reduced {
+			reduced[i] = true
+		}
+	}
+	for _, axis := range axes {
+		reduced[axis] = true
+	}
+
+	perm := make([]int, 0, len(t.shape))
+	shape := make([]int, 0, len(t.shape))
+	inner := 1
+
+	for i, r := range reduced {
+		if !r {
+			perm = append(perm, i)
+			shape = append(shape, t.shape[i])
+		} else if keepdim {
+			shape = append(shape, 1)
+		}
 	}
+	for i, r := range reduced {
+		if r {
+			perm = append(perm, i)
+			inner *= t.shape[i]
+		}
+	}
+
+	if len(shape) == 0 {
+		shape = nil
+	}
+
+	view := t
+	if len(perm) > 0 {
+		view = t.Permute(perm...)
+	}
+
+	return view, shape, inner
+}
+
+// minOf returns the minimum value of a slice.
+func minOf[T Number](s []T) T {
+	min := s[0]
+	for i := 1; i < len(s); i++ {
+		if s[i] < min {
+			min = s[i]
+		}
+	}
+	return min
+}
+
+// maxOf returns the maximum value of a slice.
+func maxOf[T Number](s []T) T {
+	max := s[0]
+	for i := 1; i < len(s); i++ {
+		if s[i] > max {
+			max = s[i]
+		}
+	}
+	return max
+}
+
+// sumOf returns the sum of a slice.
+func sumOf[T Number](s []T) T {
+	var sum T
+	for i := 0; i < len(s); i++ {
+		sum += s[i]
+	}
+	return sum
+}
+
+// prodOf returns the product of a slice.
+func prodOf[T Number](s []T) T {
+	var prod T = 1
+	for i := 0; i < len(s); i++ {
+		prod *= s[i]
+	}
+	return prod
+}
+
+// sumReducer returns a Reducer computing the sum of elements.
+func sumReducer[T Number]() Reducer[T, T] {
+	return Reducer[T, T]{
+		Identity: func() T { return 0 },
+		Chunk:    func(acc T, s []T) T { return acc + sumOf(s) },
+		Combine:  func(x, y T) T { return x + y },
+	}
+}
+
+// prodReducer returns a Reducer computing the product of elements.
+func prodReducer[T Number]() Reducer[T, T] {
+	return Reducer[T, T]{
+		Identity: func() T { return 1 },
+		Chunk:    func(acc T, s []T) T { return acc * prodOf(s) },
+		Combine:  func(x, y T) T { return x * y },
+	}
+}
+
+// An extremum accumulates the best element seen so far.
+type extremum[T Number] struct {
+	val T
+	ok  bool // whether or not any element has been seen
+}
+
+// extremumReducer returns a Reducer computing the element
+// that no other element is better than.
+func extremumReducer[T Number](better func(T, T) bool, bestOf func([]T) T) Reducer[T, extremum[T]] {
+	combine := func(x, y extremum[T]) extremum[T] {
+		if !x.ok || y.ok && better(y.val, x.val) {
+			return y
+		}
+		return x
+	}
+
+	return Reducer[T, extremum[T]]{
+		Identity: func() extremum[T] { return extremum[T]{} },
+		Chunk: func(acc extremum[T], s []T) extremum[T] {
+			if len(s) == 0 {
+				return acc
+			}
+			return combine(acc, extremum[T]{val: bestOf(s), ok: true})
+		},
+		Combine: combine,
+	}
+}
+
+// A moment accumulates the sum and count of elements.
+type moment[T Number] struct {
+	sum T
+	n   int
+}
+
+// meanReducer returns a Reducer accumulating the
+// sum and count of elements in order to compute their mean.
+func meanReducer[T Number]() Reducer[T, moment[T]] {
+	return Reducer[T, moment[T]]{
+		Identity: func() moment[T] { return moment[T]{} },
+		Chunk: func(acc moment[T], s []T) moment[T] {
+			return moment[T]{acc.sum + sumOf(s), acc.n + len(s)}
+		},
+		Combine: func(x, y moment[T]) moment[T] {
+			return moment[T]{x.sum + y.sum, x.n + y.n}
+		},
+	}
+}
+
+// An argument accumulates the index of the best element
+// seen so far, along with the number of elements seen.
+type argument[T Number] struct {
+	val    T
+	idx, n int
+}
+
+// argReducer returns a Reducer computing the index of the first
+// element that no other element is better than.
+// Ties are always broken in favor of the lowest index, since
+// partial accumulators are combined in order.
+func argReducer[T Number](better func(T, T) bool) Reducer[T, argument[T]] {
+	return Reducer[T, argument[T]]{
+		Identity: func() argument[T] { return argument[T]{} },
+		Chunk: func(acc argument[T], s []T) argument[T] {
+			for i, x := range s {
+				if acc.n+i == 0 || better(x, acc.val) {
+					acc.val, acc.idx = x, acc.n+i
+				}
+			}
+			acc.n += len(s)
+			return acc
+		},
+		Combine: func(x, y argument[T]) argument[T] {
+			if x.n == 0 || y.n != 0 && better(y.val, x.val) {
+				return argument[T]{y.val, x.n + y.idx, x.n + y.n}
+			}
+			return argument[T]{x.val, x.idx, x.n + y.n}
+		},
+	}
+}
+
+// argIndex returns the index held by an argument accumulator.
+func argIndex[T Number](acc argument[T]) int {
+	return acc.idx
+}
+
+// less reports whether x is less than y.
+func less[T Number](x, y T) bool {
+	return x < y
+}
+
+// greater reports whether x is greater than y.
+func greater[T Number](x, y T) bool {
+	return x > y
 }
 
 // Min returns the minimum value of all elements in the Tensor.
 func (t Tensor[T]) Min() Tensor[T] {
-	return t.Reduce(func(s []T) T {
-		min := s[0]
-		for i := 1; i < len(s); i++ {
-			if s[i] < min {
-				min = s[i]
-			}
-		}
-		return min
-	})
+	return t.MinAxis(false)
 }
 
 // Max returns the maximum value of all elements in the Tensor.
 func (t Tensor[T]) Max() Tensor[T] {
-	return t.Reduce(func(s []T) T {
-		max := s[0]
-		for i := 1; i < len(s); i++ {
-			if s[i] > max {
-				max = s[i]
-			}
-		}
-		return max
-	})
+	return t.MaxAxis(false)
 }
 
 // Mean returns the mean value of all elements in the Tensor.
 func (t Tensor[T]) Mean() Tensor[T] {
-	return t.Reduce(func(s []T) T {
-		var sum T
-		for i := 0; i < len(s); i++ {
-			sum += s[i]
-		}
-		return sum / T(len(s))
-	})
+	return t.MeanAxis(false)
 }
 
 // Sum returns the sum of all elements in the Tensor.
 func (t Tensor[T]) Sum() Tensor[T] {
-	return t.Reduce(func(s []T) T {
-		var sum T
-		for i := 0; i < len(s); i++ {
-			sum += s[i]
-		}
-		return sum
-	})
+	return t.SumAxis(false)
 }
 
 // Prod returns the product of all elements in the Tensor.
 func (t Tensor[T]) Prod() Tensor[T] {
-	return t.Reduce(func(s []T) T {
-		var prod T = 1
-		for i := 0; i < len(s); i++ {
-			prod *= s[i]
-		}
-		return prod
-	})
+	return t.ProdAxis(false)
+}
+
+// MinAxis returns the minimum value of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) MinAxis(keepdim bool, axes ...int) Tensor[T] {
+	return foldAxis("MinAxis", t, extremumReducer(less[T], minOf[T]), func(acc extremum[T]) T {
+		return acc.val
+	}, keepdim, axes...)
+}
+
+// MaxAxis returns the maximum value of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) MaxAxis(keepdim bool, axes ...int) Tensor[T] {
+	return foldAxis("MaxAxis", t, extremumReducer(greater[T], maxOf[T]), func(acc extremum[T]) T {
+		return acc.val
+	}, keepdim, axes...)
+}
+
+// MeanAxis returns the mean value of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) MeanAxis(keepdim bool, axes ...int) Tensor[T] {
+	return foldAxis("MeanAxis", t, meanReducer[T](), func(acc moment[T]) T {
+		return acc.sum / T(acc.n)
+	}, keepdim, axes...)
+}
+
+// SumAxis returns the sum of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) SumAxis(keepdim bool, axes ...int) Tensor[T] {
+	return t.reduceAxis("SumAxis", sumReducer[T](), keepdim, axes...)
+}
+
+// ProdAxis returns the product of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) ProdAxis(keepdim bool, axes ...int) Tensor[T] {
+	return t.reduceAxis("ProdAxis", prodReducer[T](), keepdim, axes...)
+}
+
+// ArgMin returns the flat row-major index of the minimum value
+// of all elements in the Tensor. If the minimum value occurs
+// more than once, the lowest index is returned.
+func (t Tensor[T]) ArgMin() Tensor[int] {
+	return foldAxis("ArgMin", t, argReducer(less[T]), argIndex[T], false)
+}
+
+// ArgMax returns the flat row-major index of the maximum value
+// of all elements in the Tensor. If the maximum value occurs
+// more than once, the lowest index is returned.
+func (t Tensor[T]) ArgMax() Tensor[int] {
+	return foldAxis("ArgMax", t, argReducer(greater[T]), argIndex[T], false)
+}
+
+// ArgMinAxis returns the indices of the minimum values of the Tensor's
+// elements along the given axis. Ties are broken in favor of the lowest index.
+func (t Tensor[T]) ArgMinAxis(keepdim bool, axis int) Tensor[int] {
+	return foldAxis("ArgMinAxis", t, argReducer(less[T]), argIndex[T], keepdim, axis)
+}
+
+// ArgMaxAxis returns the indices of the maximum values of the Tensor's
+// elements along the given axis. Ties are broken in favor of the lowest index.
+func (t Tensor[T]) ArgMaxAxis(keepdim bool, axis int) Tensor[int] {
+	return foldAxis("ArgMaxAxis", t, argReducer(greater[T]), argIndex[T], keepdim, axis)
 }
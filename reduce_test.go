@@ -8,8 +8,69 @@ import (
 	"testing"
 
 	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
 )
 
+func TestSumAxis(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	rows := tensor.SumAxis(false, 1)
+	if !slices.Equal(rows.Ravel(), []int{3, 12}) {
+		t.Error("sum along axis 1 is incorrect")
+	}
+
+	if !slices.Equal(rows.Shape(), []int{2}) {
+		t.Error("sum along axis 1 has the wrong shape")
+	}
+
+	cols := tensor.SumAxis(true, 0)
+	if !slices.Equal(cols.Ravel(), []int{3, 5, 7}) {
+		t.Error("sum along axis 0 is incorrect")
+	}
+
+	if !slices.Equal(cols.Shape(), []int{1, 3}) {
+		t.Error("sum along axis 0 did not keep the reduced axis")
+	}
+
+	all := tensor.SumAxis(false)
+	if all.Rank() != 0 || all.Scalar() != 15 {
+		t.Error("sum along all axes is incorrect")
+	}
+}
+
+func TestReduceAxisView(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	// the view's elements are not a contiguous range of the buffer
+	view := tensor.Permute(2, 1, 0).Slice(1, 3)
+
+	max := view.MaxAxis(false, 0, 2)
+	if !slices.Equal(max.Ravel(), []int{14, 18, 22}) {
+		t.Error("max along axes of a view is incorrect")
+	}
+
+	min := view.MinAxis(true, 1)
+	if !slices.Equal(min.Ravel(), []int{1, 13, 2, 14}) {
+		t.Error("min along an axis of a view is incorrect")
+	}
+
+	if !slices.Equal(min.Shape(), []int{2, 1, 2}) {
+		t.Error("min along an axis of a view has the wrong shape")
+	}
+}
+
+func TestReduceAxisBadAxes(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	if tensor.SumAxis(false, 2).Err == nil {
+		t.Error("reduced along an out of bounds axis")
+	}
+
+	if tensor.SumAxis(false, 1, 1).Err == nil {
+		t.Error("reduced along a repeated axis")
+	}
+}
+
 func BenchmarkMin(b *testing.B) {
 	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
 		tensor.Min()
@@ -39,3 +100,105 @@ func BenchmarkProd(b *testing.B) {
 		tensor.Prod()
 	})
 }
+
+func TestArgMinMax(t *testing.T) {
+	tensor := nune.From[int]([][]int{{3, 1, 4, 1}, {5, 9, 2, 9}})
+
+	if tensor.ArgMin().Scalar() != 1 {
+		t.Error("argmin did not return the first minimum's index")
+	}
+
+	if tensor.ArgMax().Scalar() != 5 {
+		t.Error("argmax did not return the first maximum's index")
+	}
+
+	if !slices.Equal(tensor.ArgMaxAxis(false, 1).Ravel(), []int{2, 1}) {
+		t.Error("argmax along axis 1 is incorrect")
+	}
+
+	argmin := tensor.ArgMinAxis(true, 0)
+	if !slices.Equal(argmin.Ravel(), []int{0, 0, 1, 0}) {
+		t.Error("argmin along axis 0 is incorrect")
+	}
+
+	if !slices.Equal(argmin.Shape(), []int{1, 4}) {
+		t.Error("argmin along axis 0 did not keep the reduced axis")
+	}
+}
+
+func TestArgMaxTies(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	tensor := nune.Zeros[int](1000)
+	tensor.Ravel()[700] = 1
+	tensor.Ravel()[900] = 1
+
+	for _, n := range []int{1, 3, 8} {
+		nune.EnvConfig.NumCPU = n
+		if tensor.ArgMax().Scalar() != 700 {
+			t.Errorf("argmax ties were not broken deterministically with %d cpus", n)
+		}
+	}
+}
+
+func TestMeanNumCPU(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	tensor := nune.Range[float64](0, 10, 1)
+
+	for n := 1; n <= 8; n++ {
+		nune.EnvConfig.NumCPU = n
+		if mean := tensor.Mean().Scalar(); mean != 4.5 {
+			t.Errorf("mean with %d cpus is %v instead of 4.5", n, mean)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	// counts the even elements, with an accumulator
+	// whose type differs from the Tensor's
+	evens := nune.Reducer[uint8, int]{
+		Identity: func() int { return 0 },
+		Step: func(acc int, x uint8) int {
+			if x%2 == 0 {
+				acc++
+			}
+			return acc
+		},
+		Combine: func(x, y int) int { return x + y },
+	}
+
+	tensor := nune.Range[uint8](0, 255, 1)
+
+	for _, n := range []int{1, 4, 7} {
+		nune.EnvConfig.NumCPU = n
+		if count, err := nune.Fold(tensor, evens); err != nil || count != 128 {
+			t.Errorf("fold with %d cpus counted %d even elements instead of 128", n, count)
+		}
+	}
+
+	if _, err := nune.Fold(tensor.Reshape(3, 3), evens); err == nil {
+		t.Error("fold of a tensor holding an error does not return it")
+	}
+
+	rows := nune.FoldAxis(tensor.Slice(0, 254).Reshape(2, 127), evens, func(acc int) float64 {
+		return float64(acc)
+	}, false, 1)
+	if !slices.Equal(rows.Ravel(), []float64{64, 63}) {
+		t.Error("fold along axis 1 is incorrect")
+	}
+}
+
+func BenchmarkArgMax(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.ArgMax()
+	})
+}
+
+func BenchmarkSumAxis(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Reshape(1000, 10000).SumAxis(false, 0)
+	})
+}
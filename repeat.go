@@ -0,0 +1,157 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// Tile returns a new Tensor made of the Tensor repeated along each axis
+// as many times as given by reps. If reps holds fewer counts than the
+// Tensor's rank, the leading axes are repeated once, and if it holds
+// more, the Tensor is given new leading axes of dimension 1.
+// The counts must be positive.
+func (t Tensor[T]) Tile(reps ...int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	for _, r := range reps {
+		if r <= 0 {
+			if EnvConfig.Interactive {
+				panic(ErrBadRepeats)
+			} else {
+				t.Err = ErrBadRepeats
+				return t
+			}
+		}
+	}
+
+	rank := len(t.shape)
+	if len(reps) > rank {
+		rank = len(reps)
+	}
+
+	// the result is laid out as a Tensor whose axes alternate between
+	// a repetition axis, with a stride of 0, and one of the Tensor's axes
+	tiled := make([]int, 2*rank)
+	stride := make([]int, 2*rank)
+	shape := make([]int, rank)
+
+	for i := 0; i < rank; i++ {
+		r, d, s := 1, 1, 0
+		if j := i - rank + len(reps); j >= 0 {
+			r = reps[j]
+		}
+		if j := i - rank + len(t.shape); j >= 0 {
+			d, s = t.shape[j], t.stride[j]
+		}
+
+		tiled[2*i], tiled[2*i+1] = r, d
+		stride[2*i+1] = s
+		shape[i] = r * d
+	}
+
+	if rank == 0 {
+		shape = nil
+	}
+
+	data := slices.WithLen[T](slices.Prod(shape))
+	handleLayouts(tiled, [][]int{configStride(tiled), stride}, []int{0, t.offset}, func(pos []int) {
+		data[pos[0]] = t.data[pos[1]]
+	}, configCPU(len(data)))
+
+	return Tensor[T]{
+		data:   data,
+		shape:  shape,
+		stride: configStride(shape),
+	}
+}
+
+// RepeatInterleave returns a new Tensor with each of the Tensor's entries
+// along the given axis repeated consecutively, as many times as given by
+// repeats, which is either an int counting repetitions for all entries,
+// or a rank 1 Tensor[int] holding a count per entry. The counts
+// must be positive.
+func (t Tensor[T]) RepeatInterleave(repeats any, axis int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	axis, err := normAxis("RepeatInterleave", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	size := t.shape[axis]
+	counts := make([]int, size)
+
+	switch r := repeats.(type) {
+	case int:
+		for i := range counts {
+			counts[i] = r
+		}
+	case Tensor[int]:
+		if r.Err != nil {
+			if EnvConfig.Interactive {
+				panic(r.Err)
+			} else {
+				t.Err = r.Err
+				return t
+			}
+		}
+
+		if len(r.shape) != 1 || r.shape[0] != size {
+			if EnvConfig.Interactive {
+				panic(ErrBadRepeats)
+			} else {
+				t.Err = ErrBadRepeats
+				return t
+			}
+		}
+
+		copy(counts, r.gather(0, size, nil))
+	default:
+		if EnvConfig.Interactive {
+			panic(ErrBadRepeats)
+		} else {
+			t.Err = ErrBadRepeats
+			return t
+		}
+	}
+
+	// entries maps each entry of the result along
+	// the axis to the Tensor's entry it repeats
+	var entries []int
+	for i, c := range counts {
+		if c <= 0 {
+			if EnvConfig.Interactive {
+				panic(ErrBadRepeats)
+			} else {
+				t.Err = ErrBadRepeats
+				return t
+			}
+		}
+
+		for ; c > 0; c-- {
+			entries = append(entries, i)
+		}
+	}
+
+	return t.selectEntries(axis, entries)
+}
@@ -0,0 +1,85 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestTile(t *testing.T) {
+	tensor := nune.Range[int](0, 4, 1).Reshape(2, 2)
+
+	tiled := tensor.Tile(2, 3)
+	if !slices.Equal(tiled.Shape(), []int{4, 6}) {
+		t.Fatal("tile has the wrong shape")
+	}
+
+	if !slices.Equal(tiled.Index(3).Ravel(), []int{2, 3, 2, 3, 2, 3}) {
+		t.Error("tile is incorrect")
+	}
+
+	if !slices.Equal(tensor.Tile(2).Ravel(), []int{0, 1, 0, 1, 2, 3, 2, 3}) {
+		t.Error("tile of the last axis is incorrect")
+	}
+
+	if !slices.Equal(tensor.Tile(2, 1, 1).Shape(), []int{2, 2, 2}) {
+		t.Error("tile with more counts than axes has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.T().Tile(1, 2).Ravel(), []int{0, 2, 0, 2, 1, 3, 1, 3}) {
+		t.Error("tile of a view is incorrect")
+	}
+
+	if !errors.Is(tensor.Tile(-1).Err, nune.ErrBadRepeats) {
+		t.Error("tiled a negative number of times")
+	}
+
+	if !errors.Is(tensor.Tile(0, 1).Err, nune.ErrBadRepeats) || !errors.Is(tensor.Tile(1, 0).Err, nune.ErrBadRepeats) {
+		t.Error("tiled zero times")
+	}
+}
+
+func TestRepeatInterleave(t *testing.T) {
+	tensor := nune.Range[int](0, 4, 1).Reshape(2, 2)
+
+	if !slices.Equal(tensor.RepeatInterleave(2, 0).Ravel(), []int{0, 1, 0, 1, 2, 3, 2, 3}) {
+		t.Error("repeat interleave along the first axis is incorrect")
+	}
+
+	if !slices.Equal(tensor.RepeatInterleave(2, -1).Ravel(), []int{0, 0, 1, 1, 2, 2, 3, 3}) {
+		t.Error("repeat interleave along the last axis is incorrect")
+	}
+
+	repeated := tensor.RepeatInterleave(nune.From[int]([]int{1, 2}), 1)
+	if !slices.Equal(repeated.Shape(), []int{2, 3}) || !slices.Equal(repeated.Ravel(), []int{0, 1, 1, 2, 3, 3}) {
+		t.Error("repeat interleave of per-entry counts is incorrect")
+	}
+
+	if !errors.Is(tensor.RepeatInterleave(nune.From[int]([]int{1, 0}), 1).Err, nune.ErrBadRepeats) {
+		t.Error("repeat interleave of a zero per-entry count")
+	}
+
+	if !errors.Is(tensor.RepeatInterleave(nune.From[int]([]int{1, 2, 3}), 1).Err, nune.ErrBadRepeats) {
+		t.Error("repeat interleave of mismatched counts")
+	}
+
+	if !errors.Is(tensor.RepeatInterleave(-1, 0).Err, nune.ErrBadRepeats) {
+		t.Error("repeat interleave of a negative count")
+	}
+
+	if !errors.Is(tensor.RepeatInterleave(0, 1).Err, nune.ErrBadRepeats) {
+		t.Error("repeat interleave of a zero count")
+	}
+}
+
+func BenchmarkTile(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Tile(2)
+	})
+}
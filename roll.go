@@ -0,0 +1,103 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// Roll returns a new Tensor made of the Tensor's entries along the given
+// axis shifted by the given amount, the entries shifted past the end of
+// the axis wrapping around to its start. A negative shift rolls the
+// entries backwards.
+func (t Tensor[T]) Roll(shift, axis int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	axis, err := normAxis("Roll", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	size := t.shape[axis]
+	entries := make([]int, size)
+	for i := range entries {
+		entries[i] = ((i-shift)%size + size) % size
+	}
+
+	return t.selectEntries(axis, entries)
+}
+
+// reversed returns a view over the Tensor with
+// its entries along the given axis reversed.
+func (t Tensor[T]) reversed(axis int) Tensor[T] {
+	shape := slices.Clone(t.shape)
+	stride := slices.Clone(t.stride)
+	offset := t.offset
+
+	if shape[axis] > 0 {
+		offset += (shape[axis] - 1) * stride[axis]
+	}
+	stride[axis] = -stride[axis]
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  shape,
+		stride: stride,
+		offset: offset,
+	}
+}
+
+// Rot90 returns a view over the Tensor rotated by 90 degrees k times
+// in the plane of the two given axes, in the direction going from the
+// first axis towards the second one. A negative k rotates the Tensor
+// in the opposite direction.
+func (t Tensor[T]) Rot90(k int, axes [2]int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	norm, err := normAxes("Rot90", len(t.shape), axes[0], axes[1])
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	a, b := norm[0], norm[1]
+
+	switch ((k % 4) + 4) % 4 {
+	case 1:
+		return t.reversed(b).Transpose(a, b)
+	case 2:
+		return t.reversed(a).reversed(b)
+	case 3:
+		return t.Transpose(a, b).reversed(b)
+	default:
+		return Tensor[T]{
+			data:   t.data,
+			shape:  slices.Clone(t.shape),
+			stride: slices.Clone(t.stride),
+			offset: t.offset,
+		}
+	}
+}
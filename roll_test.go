@@ -0,0 +1,68 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestRoll(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	if !slices.Equal(tensor.Roll(1, -1).Ravel(), []int{2, 0, 1, 5, 3, 4}) {
+		t.Error("roll along the last axis is incorrect")
+	}
+
+	if !slices.Equal(tensor.Roll(-4, 1).Ravel(), []int{1, 2, 0, 4, 5, 3}) {
+		t.Error("backward roll wider than the axis is incorrect")
+	}
+
+	if !slices.Equal(tensor.T().Roll(1, 0).Ravel(), []int{2, 5, 0, 3, 1, 4}) {
+		t.Error("roll of a view is incorrect")
+	}
+
+	if tensor.Roll(1, 2).Err == nil {
+		t.Error("rolled along an out of bounds axis")
+	}
+}
+
+func TestRot90(t *testing.T) {
+	tensor := nune.Range[int](1, 5, 1).Reshape(2, 2)
+
+	cases := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4}},
+		{1, []int{2, 4, 1, 3}},
+		{2, []int{4, 3, 2, 1}},
+		{3, []int{3, 1, 4, 2}},
+		{-1, []int{3, 1, 4, 2}},
+	}
+
+	for _, c := range cases {
+		if got := elements(tensor.Rot90(c.k, [2]int{0, 1})); !slices.Equal(got, c.want) {
+			t.Errorf("rotation by %d is %v, want %v", c.k, got, c.want)
+		}
+	}
+
+	batch := nune.Range[int](0, 12, 1).Reshape(2, 2, 3)
+	rotated := batch.Rot90(1, [2]int{-2, -1})
+	if !slices.Equal(rotated.Shape(), []int{2, 3, 2}) || !slices.Equal(elements(rotated.Index(1)), []int{8, 11, 7, 10, 6, 9}) {
+		t.Error("rotation of a batch is incorrect")
+	}
+
+	rotated.Assign(0)
+	if !slices.Equal(batch.Ravel(), make([]int, 12)) {
+		t.Error("rotation is not a view")
+	}
+
+	if tensor.Rot90(1, [2]int{1, -1}).Err == nil {
+		t.Error("rotated in the plane of a repeated axis")
+	}
+}
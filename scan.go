@@ -0,0 +1,188 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"sync"
+)
+
+// scanLine performs a sequential inclusive scan over n elements
+// of the data buffer, starting at base and spaced by stride.
+func scanLine[T Number](data []T, base, n, stride int, f func(T, T) T) {
+	pos := base
+	for i := 1; i < n; i++ {
+		next := pos + stride
+		data[next] = f(data[pos], data[next])
+		pos = next
+	}
+}
+
+// handleLineScan processes an inclusive scan over a single line
+// of the data buffer accordingly. The line is split into chunks that
+// are scanned independently, then each chunk is offset by the
+// carry of all chunks preceding it.
+func handleLineScan[T Number](data []T, base, n, stride int, f func(T, T) T, nCPU int) {
+	if nCPU <= 1 {
+		scanLine(data, base, n, stride, f)
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < nCPU; i++ {
+		min := (i * n / nCPU)
+		max := ((i + 1) * n) / nCPU
+
+		wg.Add(1)
+		go func(min, max int) {
+			scanLine(data, base+min*stride, max-min, stride, f)
+
+			wg.Done()
+		}(min, max)
+	}
+
+	wg.Wait()
+
+	carries := make([]T, nCPU)
+	carry := data[base+(n/nCPU-1)*stride]
+	for i := 1; i < nCPU; i++ {
+		carries[i] = carry
+		carry = f(carry, data[base+(((i+1)*n)/nCPU-1)*stride])
+	}
+
+	for i := 1; i < nCPU; i++ {
+		min := (i * n / nCPU)
+		max := ((i + 1) * n) / nCPU
+
+		wg.Add(1)
+		go func(carry T, min, max int) {
+			for j := min; j < max; j++ {
+				data[base+j*stride] = f(carry, data[base+j*stride])
+			}
+
+			wg.Done()
+		}(carries[i], min, max)
+	}
+
+	wg.Wait()
+}
+
+// handleScan processes an inclusive scan over lines of n elements
+// of the data buffer, each starting at one of the given positions
+// and spaced by stride, accordingly.
+func handleScan[T Number](data []T, lines []int, n, stride int, f func(T, T) T, nCPU int) {
+	if len(lines) < nCPU {
+		// too few lines to keep every goroutine busy,
+		// so parallelize each line's scan instead
+		for _, base := range lines {
+			handleLineScan(data, base, n, stride, f, configCPU(n))
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < nCPU; i++ {
+		min := (i * len(lines) / nCPU)
+		max := ((i + 1) * len(lines)) / nCPU
+
+		wg.Add(1)
+		go func(bases []int) {
+			for _, base := range bases {
+				scanLine(data, base, n, stride, f)
+			}
+
+			wg.Done()
+		}(lines[min:max])
+	}
+
+	wg.Wait()
+}
+
+// Scan replaces each element of the Tensor with the result of
+// cumulatively applying f to all elements preceding it along the given axis,
+// itself included. The operation must be associative, since the scan
+// might be multi-threaded if the Tensor is big enough.
+func (t Tensor[T]) Scan(f func(T, T) T, axis int) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	axis, err = normAxis("Scan", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	outerShape := make([]int, 0, len(t.shape)-1)
+	outerStride := make([]int, 0, len(t.stride)-1)
+	for i := range t.shape {
+		if i != axis {
+			outerShape = append(outerShape, t.shape[i])
+			outerStride = append(outerStride, t.stride[i])
+		}
+	}
+
+	lines := make([]int, 0, t.Numel()/t.shape[axis])
+	walkLayout(outerShape, outerStride, t.offset, 0, cap(lines), func(pos int) {
+		lines = append(lines, pos)
+	})
+
+	handleScan(t.data, lines, t.shape[axis], t.stride[axis], f, configCPU(t.Numel()))
+
+	return t
+}
+
+// CumSum computes the cumulative sum of the Tensor's elements along the given axis.
+func (t Tensor[T]) CumSum(axis int) Tensor[T] {
+	return t.Scan(func(x, y T) T {
+		return x + y
+	}, axis)
+}
+
+// CumProd computes the cumulative product of the Tensor's elements along the given axis.
+func (t Tensor[T]) CumProd(axis int) Tensor[T] {
+	return t.Scan(func(x, y T) T {
+		return x * y
+	}, axis)
+}
+
+// CumMin computes the cumulative minimum of the Tensor's elements along the given axis.
+func (t Tensor[T]) CumMin(axis int) Tensor[T] {
+	return t.Scan(func(x, y T) T {
+		if y < x {
+			return y
+		}
+		return x
+	}, axis)
+}
+
+// CumMax computes the cumulative maximum of the Tensor's elements along the given axis.
+func (t Tensor[T]) CumMax(axis int) Tensor[T] {
+	return t.Scan(func(x, y T) T {
+		if y > x {
+			return y
+		}
+		return x
+	}, axis)
+}
@@ -0,0 +1,59 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestCumSum(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	if !slices.Equal(tensor.Clone().CumSum(1).Ravel(), []int{0, 1, 3, 3, 7, 12}) {
+		t.Error("cumulative sum along axis 1 is incorrect")
+	}
+
+	if !slices.Equal(tensor.Clone().CumSum(0).Ravel(), []int{0, 1, 2, 3, 5, 7}) {
+		t.Error("cumulative sum along axis 0 is incorrect")
+	}
+
+	// the scan is performed in place on the view's elements
+	tensor.Permute(1, 0).CumMax(0)
+	if !slices.Equal(tensor.Ravel(), []int{0, 1, 2, 3, 4, 5}) {
+		t.Error("cumulative max along a permuted axis is incorrect")
+	}
+
+	tensor.Permute(1, 0).CumProd(1)
+	if !slices.Equal(tensor.Ravel(), []int{0, 1, 2, 0, 4, 10}) {
+		t.Error("cumulative product along a permuted axis is incorrect")
+	}
+}
+
+func TestCumSumNumCPU(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	for _, n := range []int{1, 3, 8} {
+		nune.EnvConfig.NumCPU = n
+
+		tensor := nune.Ones[int](1001).CumSum(0)
+		if !slices.Equal(tensor.Ravel(), nune.Range[int](1, 1002, 1).Ravel()) {
+			t.Errorf("cumulative sum with %d cpus is incorrect", n)
+		}
+
+		tensor = nune.Range[int](0, 10, 1).Reshape(5, 2).CumMin(0)
+		if !slices.Equal(tensor.Ravel(), []int{0, 1, 0, 1, 0, 1, 0, 1, 0, 1}) {
+			t.Errorf("cumulative min with %d cpus is incorrect", n)
+		}
+	}
+}
+
+func BenchmarkCumSum(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.CumSum(0)
+	})
+}
@@ -0,0 +1,388 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vorduin/slices"
+)
+
+// None stands for an omitted start or stop of a slice, which then
+// extends to the end of the axis in the direction of the step.
+const None = math.MinInt
+
+// specKind is the kind of indexing described by a Spec.
+type specKind int
+
+const (
+	specSlice specKind = iota
+	specIndex
+	specEllipsis
+	specNewAxis
+)
+
+// A Spec describes how a Tensor is indexed along one of its axes,
+// as part of a multi-axis slicing.
+type Spec struct {
+	kind              specKind
+	start, stop, step int
+}
+
+var (
+	// Ellipsis stands for as many full slices as needed
+	// for a slicing to cover all of a Tensor's axes.
+	Ellipsis = Spec{kind: specEllipsis}
+
+	// NewAxis inserts a new axis of dimension 1.
+	NewAxis = Spec{kind: specNewAxis}
+
+	// All is a full slice of an axis.
+	All = Spec{kind: specSlice, start: None, stop: None, step: 1}
+)
+
+// S returns a Spec slicing an axis from start to stop, exclusive, with
+// an optional step of 1 by default. Negative indices count from the end
+// of the axis, and a negative step walks the axis backwards.
+// Either of start and stop can be None.
+func S(start, stop int, step ...int) Spec {
+	s := Spec{
+		kind:  specSlice,
+		start: start,
+		stop:  stop,
+		step:  1,
+	}
+
+	if len(step) > 0 {
+		s.step = step[0]
+	}
+
+	return s
+}
+
+// At returns a Spec selecting a single index of an axis, thus removing
+// the axis. A negative index counts from the end of the axis.
+func At(idx int) Spec {
+	return Spec{
+		kind:  specIndex,
+		start: idx,
+	}
+}
+
+// ParseSpecs parses a slicing written as in Python, such as
+// "1:-1, ::2, ..., None", into its Specs.
+// Each comma separated item is either an index, a slice,
+// an ellipsis, or None for a new axis.
+func ParseSpecs(s string) ([]Spec, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var specs []Spec
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+
+		switch {
+		case item == "...":
+			specs = append(specs, Ellipsis)
+		case item == "None":
+			specs = append(specs, NewAxis)
+		case strings.Contains(item, ":"):
+			parts := strings.Split(item, ":")
+			if len(parts) > 3 {
+				return nil, ErrBadSlicing
+			}
+
+			bounds := []int{None, None, 1}
+			for i, part := range parts {
+				part = strings.TrimSpace(part)
+				if part == "" {
+					continue
+				}
+
+				n, err := strconv.Atoi(part)
+				if err != nil {
+					return nil, ErrBadSlicing
+				}
+				bounds[i] = n
+			}
+
+			specs = append(specs, S(bounds[0], bounds[1], bounds[2]))
+		default:
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return nil, ErrBadSlicing
+			}
+
+			specs = append(specs, At(n))
+		}
+	}
+
+	return specs, nil
+}
+
+// sliceBounds returns the first index, the step and the number of
+// elements of a slice over an axis of the given dimensions,
+// clamping its bounds as Python does.
+func sliceBounds(s Spec, size int) (int, int, int) {
+	lower, upper := 0, size
+	if s.step < 0 {
+		lower, upper = -1, size-1
+	}
+
+	clamp := func(idx, omitted int) int {
+		switch {
+		case idx == None:
+			return omitted
+		case idx < 0:
+			idx += size
+			if idx < lower {
+				return lower
+			}
+		case idx > upper:
+			return upper
+		}
+		return idx
+	}
+
+	var start, stop int
+	if s.step > 0 {
+		start, stop = clamp(s.start, lower), clamp(s.stop, upper)
+	} else {
+		start, stop = clamp(s.start, upper), clamp(s.stop, lower)
+	}
+
+	n := 0
+	if s.step > 0 && stop > start {
+		n = (stop - start + s.step - 1) / s.step
+	} else if s.step < 0 && start > stop {
+		n = (start - stop - s.step - 1) / -s.step
+	}
+
+	return start, s.step, n
+}
+
+// sliceLayout returns the layout of the view over the
+// Tensor described by the given slicing.
+func (t Tensor[T]) sliceLayout(specs []Spec) ([]int, []int, int, error) {
+	consumed, ellipsis := 0, false
+	for _, s := range specs {
+		switch s.kind {
+		case specSlice, specIndex:
+			consumed++
+		case specEllipsis:
+			if ellipsis {
+				return nil, nil, 0, ErrBadSlicing
+			}
+			ellipsis = true
+		}
+	}
+
+	err := verifyArgsBounds(consumed, len(t.shape))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if !ellipsis {
+		specs = append(slices.Clone(specs), Ellipsis)
+	}
+
+	var shape, stride []int
+	offset := t.offset
+	axis := 0
+
+	for _, s := range specs {
+		switch s.kind {
+		case specEllipsis:
+			for n := len(t.shape) - consumed; n > 0; n-- {
+				shape = append(shape, t.shape[axis])
+				stride = append(stride, t.stride[axis])
+				axis++
+			}
+		case specNewAxis:
+			shape = append(shape, 1)
+			stride = append(stride, 0)
+		case specIndex:
+			idx := s.start
+			if idx < 0 {
+				idx += t.shape[axis]
+			}
+
+			if idx < 0 || idx >= t.shape[axis] {
+				return nil, nil, 0, &IndexError{
+					Index: s.start,
+					Axis:  axis,
+					Size:  t.shape[axis],
+				}
+			}
+
+			offset += idx * t.stride[axis]
+			axis++
+		case specSlice:
+			if s.step == 0 {
+				return nil, nil, 0, ErrBadStep
+			}
+
+			start, step, n := sliceBounds(s, t.shape[axis])
+			if n == 0 {
+				return nil, nil, 0, ErrBadInterval
+			}
+
+			offset += start * t.stride[axis]
+
+			shape = append(shape, n)
+			stride = append(stride, step*t.stride[axis])
+			axis++
+		}
+	}
+
+	return shape, stride, offset, nil
+}
+
+// Get returns a view over the Tensor described by the given slicing,
+// made of one Spec per axis, such as:
+//
+//	t.Get(nune.S(1, -1), nune.S(nune.None, nune.None, 2), nune.Ellipsis, nune.NewAxis)
+//
+// Axes that aren't covered by the slicing are kept whole. Slices
+// selecting no elements fail with ErrBadInterval.
+func (t Tensor[T]) Get(specs ...Spec) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	shape, stride, offset, err := t.sliceLayout(specs)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  shape,
+		stride: stride,
+		offset: offset,
+	}
+}
+
+// GetStr returns a view over the Tensor described by the given
+// slicing written as in Python, such as "1:-1, ::2, ..., None".
+func (t Tensor[T]) GetStr(s string) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	specs, err := ParseSpecs(s)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	return t.Get(specs...)
+}
+
+// Assign writes the given value - be it a numeric type, a sequence,
+// nested sequences, or another Tensor - into the Tensor's elements,
+// broadcasting it to the Tensor's shape. Since the Tensor might be
+// a view, such as the ones returned by Index, Slice, Permute or Get,
+// the write goes through to the data buffer it shares with its parent.
+func (t Tensor[T]) Assign(value any) Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return t
+		}
+	}
+
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
+		}
+	}
+
+	src, ok := value.(Tensor[T])
+	if !ok {
+		src = From[T](value)
+	}
+
+	if src.Err != nil {
+		if EnvConfig.Interactive {
+			panic(src.Err)
+		} else {
+			t.Err = src.Err
+			return t
+		}
+	}
+
+	shape, ok := broadcastShapes(t.shape, src.shape)
+	if !ok || !slices.Equal(shape, t.shape) {
+		if EnvConfig.Interactive {
+			panic(ErrStorageDump)
+		} else {
+			t.Err = ErrStorageDump
+			return t
+		}
+	}
+
+	// the source might overlap with the Tensor,
+	// in which case it must be read before being overwritten
+	if len(src.data) > 0 && len(t.data) > 0 && &src.data[0] == &t.data[0] {
+		src = src.materialize(src.shape...)
+	}
+
+	strides := [][]int{t.stride, src.broadcastStride(t.shape)}
+	handleLayouts(t.shape, strides, []int{t.offset, src.offset}, func(pos []int) {
+		t.data[pos[0]] = src.data[pos[1]]
+	}, configCPU(t.Numel()))
+
+	return t
+}
+
+// SetSlice writes the given value into the view over the Tensor
+// described by the given slicing, as Assign does, and returns the Tensor.
+func (t Tensor[T]) SetSlice(value any, specs ...Spec) Tensor[T] {
+	view := t.Get(specs...).Assign(value)
+	if view.Err != nil {
+		t.Err = view.Err
+	}
+
+	return t
+}
+
+// SetSliceStr writes the given value into the view over the Tensor
+// described by the given slicing written as in Python, as Assign does,
+// and returns the Tensor.
+func (t Tensor[T]) SetSliceStr(value any, s string) Tensor[T] {
+	view := t.GetStr(s).Assign(value)
+	if view.Err != nil {
+		t.Err = view.Err
+	}
+
+	return t
+}
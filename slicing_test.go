@@ -0,0 +1,133 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestGet(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(4, 6)
+
+	view := tensor.Get(nune.S(1, -1), nune.S(nune.None, nune.None, 2))
+	if !slices.Equal(elements(view), []int{6, 8, 10, 12, 14, 16}) {
+		t.Error("slicing with a step is incorrect")
+	}
+
+	if !slices.Equal(view.Shape(), []int{2, 3}) {
+		t.Error("slicing with a step has the wrong shape")
+	}
+
+	// the view shares the tensor's data buffer
+	view.Get(nune.At(-1), nune.At(0)).Ravel()[0] = -1
+	if tensor.Index(2, 0).Scalar() != -1 {
+		t.Error("slicing did not return a view")
+	}
+
+	back := tensor.Get(nune.At(0), nune.S(nune.None, 1, -2))
+	if !slices.Equal(elements(back), []int{5, 3}) {
+		t.Error("slicing with a negative step is incorrect")
+	}
+
+	if !errors.Is(tensor.Get(nune.S(3, 1)).Err, nune.ErrBadInterval) {
+		t.Error("slicing selected no elements")
+	}
+
+	if !errors.Is(tensor.GetStr("2:1").Err, nune.ErrBadInterval) {
+		t.Error("slicing written as in Python selected no elements")
+	}
+
+	reversed := nune.Range[int](0, 4, 1).Get(nune.S(nune.None, nune.None, -1))
+	if !slices.Equal(reversed.Ravel(), []int{3, 2, 1, 0}) {
+		t.Error("ravel of a view with a negative stride is incorrect")
+	}
+}
+
+func TestGetEllipsis(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	view := tensor.Get(nune.Ellipsis, nune.At(1), nune.NewAxis)
+	if !slices.Equal(view.Shape(), []int{2, 3, 1}) {
+		t.Error("slicing with an ellipsis and a new axis has the wrong shape")
+	}
+
+	if !slices.Equal(elements(view), []int{1, 5, 9, 13, 17, 21}) {
+		t.Error("slicing with an ellipsis is incorrect")
+	}
+
+	if tensor.Get(nune.Ellipsis, nune.At(0), nune.Ellipsis).Err == nil {
+		t.Error("sliced with two ellipses")
+	}
+
+	if tensor.Get(nune.At(0), nune.At(0), nune.At(0), nune.At(0)).Err == nil {
+		t.Error("sliced more axes than the tensor has")
+	}
+
+	var err *nune.IndexError
+	if !errors.As(tensor.Get(nune.All, nune.At(-4)).Err, &err) || err.Axis != 1 {
+		t.Error("sliced an out of bounds index")
+	}
+}
+
+func TestGetStr(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	view := tensor.GetStr("-1, ::-1, ..., 1:3, None")
+	if !slices.Equal(view.Shape(), []int{3, 2, 1}) {
+		t.Error("parsed slicing has the wrong shape")
+	}
+
+	if !slices.Equal(elements(view), []int{21, 22, 17, 18, 13, 14}) {
+		t.Error("parsed slicing is incorrect")
+	}
+
+	if tensor.GetStr("1:2:3:4").Err == nil || tensor.GetStr("a").Err == nil {
+		t.Error("parsed a malformed slicing")
+	}
+
+	if tensor.GetStr("::0").Err == nil {
+		t.Error("sliced with a null step")
+	}
+}
+
+func TestAssign(t *testing.T) {
+	tensor := nune.Zeros[int](3, 4)
+
+	// setting a row with a scalar
+	tensor.Index(1).Assign(7)
+	if !slices.Equal(tensor.Ravel(), []int{0, 0, 0, 0, 7, 7, 7, 7, 0, 0, 0, 0}) {
+		t.Error("assigning a scalar to a row is incorrect")
+	}
+
+	// setting a strided column with a slice
+	tensor.Permute(1, 0).Index(3).Assign([]int{1, 2, 3})
+	if !slices.Equal(tensor.Ravel(), []int{0, 0, 0, 1, 7, 7, 7, 2, 0, 0, 0, 3}) {
+		t.Error("assigning a slice to a column is incorrect")
+	}
+
+	// pasting a broadcast patch with a negative step
+	tensor.SetSlice(nune.FromBuffer([]int{4, 5}), nune.S(nune.None, nune.None, -2), nune.S(0, 2))
+	if !slices.Equal(tensor.Ravel(), []int{4, 5, 0, 1, 7, 7, 7, 2, 4, 5, 0, 3}) {
+		t.Error("assigning a patch to a slicing is incorrect")
+	}
+
+	if tensor.SetSliceStr([]int{1, 2, 3}, "0").Err == nil {
+		t.Error("assigned a value that does not broadcast to the view")
+	}
+}
+
+func TestAssignOverlap(t *testing.T) {
+	tensor := nune.Range[int](0, 5, 1)
+
+	// the source is a reversed view of the destination
+	tensor.Assign(tensor.Get(nune.S(nune.None, nune.None, -1)))
+	if !slices.Equal(tensor.Ravel(), []int{4, 3, 2, 1, 0}) {
+		t.Error("assigning an overlapping view is incorrect")
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"github.com/vorduin/slices"
+)
+
+// narrow returns a view over the n elements of the Tensor
+// along the given axis starting at the given index.
+func (t Tensor[T]) narrow(axis, start, n int) Tensor[T] {
+	shape := slices.Clone(t.shape)
+	shape[axis] = n
+
+	return Tensor[T]{
+		data:   t.data,
+		shape:  shape,
+		stride: slices.Clone(t.stride),
+		offset: t.offset + start*t.stride[axis],
+	}
+}
+
+// splitViews returns views over consecutive pieces
+// of the given sizes of the Tensor along the given axis.
+func (t Tensor[T]) splitViews(axis int, sizes []int) []Tensor[T] {
+	views := make([]Tensor[T], len(sizes))
+
+	start := 0
+	for i, n := range sizes {
+		views[i] = t.narrow(axis, start, n)
+		start += n
+	}
+
+	return views
+}
+
+// splitSizes returns the sizes of the pieces of an axis of the given
+// dimensions split into pieces of the given size, the last piece
+// being smaller if the size doesn't divide the dimensions.
+func splitSizes(dim, size int) []int {
+	sizes := make([]int, 0, (dim+size-1)/size)
+	for start := 0; start < dim; start += size {
+		if start+size > dim {
+			sizes = append(sizes, dim-start)
+		} else {
+			sizes = append(sizes, size)
+		}
+	}
+
+	return sizes
+}
+
+// Split splits the Tensor along the given axis into views over
+// consecutive pieces of the given sizes, which must add up to the axis's
+// dimensions. If a single size is given, the Tensor is split into pieces
+// of that size, the last piece being smaller if the size doesn't divide
+// the axis's dimensions. The views share the Tensor's data buffer.
+// If the Tensor can't be split, the returned slice only holds
+// the Tensor with its error set.
+func (t Tensor[T]) Split(axis int, sizes ...int) []Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return []Tensor[T]{t}
+		}
+	}
+
+	axis, err := normAxis("Split", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return []Tensor[T]{t}
+		}
+	}
+
+	total := 0
+	for _, n := range sizes {
+		if n <= 0 {
+			total = -1
+			break
+		}
+		total += n
+	}
+
+	if len(sizes) == 1 && total > 0 {
+		sizes = splitSizes(t.shape[axis], sizes[0])
+	} else if total != t.shape[axis] {
+		if EnvConfig.Interactive {
+			panic(ErrBadSplit)
+		} else {
+			t.Err = ErrBadSplit
+			return []Tensor[T]{t}
+		}
+	}
+
+	return t.splitViews(axis, sizes)
+}
+
+// Chunk splits the Tensor along the given axis into n views over pieces
+// of equal size, the last piece being smaller if n doesn't divide the axis's
+// dimensions, in which case there might be fewer than n pieces.
+// The views share the Tensor's data buffer. If the Tensor can't be chunked,
+// the returned slice only holds the Tensor with its error set.
+func (t Tensor[T]) Chunk(axis, n int) []Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return []Tensor[T]{t}
+		}
+	}
+
+	axis, err := normAxis("Chunk", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return []Tensor[T]{t}
+		}
+	}
+
+	if n <= 0 {
+		if EnvConfig.Interactive {
+			panic(ErrBadSplit)
+		} else {
+			t.Err = ErrBadSplit
+			return []Tensor[T]{t}
+		}
+	}
+
+	dim := t.shape[axis]
+	return t.splitViews(axis, splitSizes(dim, (dim+n-1)/n))
+}
+
+// Unbind returns views over each index of the Tensor along the
+// given axis, which is removed from the views. The views share
+// the Tensor's data buffer. If the Tensor can't be unbound,
+// the returned slice only holds the Tensor with its error set.
+func (t Tensor[T]) Unbind(axis int) []Tensor[T] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return []Tensor[T]{t}
+		}
+	}
+
+	axis, err := normAxis("Unbind", axis, len(t.shape))
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return []Tensor[T]{t}
+		}
+	}
+
+	shape := append(slices.Clone(t.shape[:axis]), t.shape[axis+1:]...)
+	stride := append(slices.Clone(t.stride[:axis]), t.stride[axis+1:]...)
+	if len(shape) == 0 {
+		shape, stride = nil, nil
+	}
+
+	views := make([]Tensor[T], t.shape[axis])
+	for i := range views {
+		views[i] = Tensor[T]{
+			data:   t.data,
+			shape:  slices.Clone(shape),
+			stride: slices.Clone(stride),
+			offset: t.offset + i*t.stride[axis],
+		}
+	}
+
+	return views
+}
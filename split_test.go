@@ -0,0 +1,84 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestSplit(t *testing.T) {
+	tensor := nune.Range[int](0, 12, 1).Reshape(2, 6)
+
+	pieces := tensor.Split(-1, 1, 2, 3)
+	if len(pieces) != 3 || !slices.Equal(pieces[2].Shape(), []int{2, 3}) {
+		t.Fatal("split into given sizes has the wrong shapes")
+	}
+
+	if !slices.Equal(elements(pieces[1]), []int{1, 2, 7, 8}) {
+		t.Error("split into given sizes is incorrect")
+	}
+
+	// the pieces are views over the tensor
+	pieces[0].Assign(-1)
+	if tensor.Index(1, 0).Scalar() != -1 {
+		t.Error("split did not return views")
+	}
+
+	pieces = tensor.Split(1, 4)
+	if len(pieces) != 2 || !slices.Equal(pieces[1].Shape(), []int{2, 2}) {
+		t.Error("split into pieces of a given size has the wrong shapes")
+	}
+
+	pieces = tensor.Split(1, 2, 2)
+	if len(pieces) != 1 || pieces[0].Err == nil {
+		t.Error("split into sizes that don't add up to the axis")
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tensor := nune.Range[int](0, 14, 1).Reshape(7, 2)
+
+	chunks := tensor.Chunk(0, 3)
+	if len(chunks) != 3 {
+		t.Fatal("chunk returned the wrong number of pieces")
+	}
+
+	if !slices.Equal(chunks[0].Shape(), []int{3, 2}) || !slices.Equal(chunks[2].Shape(), []int{1, 2}) {
+		t.Error("chunk has the wrong shapes")
+	}
+
+	if !slices.Equal(chunks[2].Ravel(), []int{12, 13}) {
+		t.Error("last chunk is incorrect")
+	}
+
+	if len(tensor.Chunk(0, 6)) != 4 {
+		t.Error("chunk into uneven pieces returned the wrong number of pieces")
+	}
+
+	if tensor.Chunk(0, 0)[0].Err == nil {
+		t.Error("chunked into no pieces")
+	}
+}
+
+func TestUnbind(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	cols := tensor.Unbind(-1)
+	if len(cols) != 3 || !slices.Equal(cols[1].Shape(), []int{2}) {
+		t.Fatal("unbind has the wrong shapes")
+	}
+
+	if !slices.Equal(elements(cols[2]), []int{2, 5}) {
+		t.Error("unbind along the last axis is incorrect")
+	}
+
+	scalars := nune.Range[int](0, 3, 1).Unbind(0)
+	if scalars[2].Rank() != 0 || scalars[2].Scalar() != 2 {
+		t.Error("unbind of a rank 1 tensor is incorrect")
+	}
+}
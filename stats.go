@@ -0,0 +1,203 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune
+
+import (
+	"math"
+	"sort"
+)
+
+// Interpolation is the method used to compute a quantile
+// that lies between two elements.
+type Interpolation int
+
+// List of interpolation methods, for a quantile lying
+// between the elements x[i] and x[j] at the fractional index i + f.
+const (
+	InterpLinear   Interpolation = iota // x[i] + f * (x[j] - x[i])
+	InterpLower                         // x[i]
+	InterpHigher                        // x[j]
+	InterpNearest                       // x[i] or x[j], whichever is nearest, ties to even
+	InterpMidpoint                      // (x[i] + x[j]) / 2
+)
+
+// A welford accumulates the count, mean and sum of squared
+// deviations from the mean of elements, using Welford's algorithm.
+type welford struct {
+	n        int
+	mean, m2 float64
+}
+
+// varReducer returns a Reducer accumulating the elements' variance,
+// merging partial accumulators with Chan et al.'s parallel algorithm.
+func varReducer[T Number]() Reducer[T, welford] {
+	return Reducer[T, welford]{
+		Identity: func() welford { return welford{} },
+		Chunk: func(acc welford, s []T) welford {
+			for _, x := range s {
+				acc.n++
+				d := float64(x) - acc.mean
+				acc.mean += d / float64(acc.n)
+				acc.m2 += d * (float64(x) - acc.mean)
+			}
+			return acc
+		},
+		Combine: func(x, y welford) welford {
+			if x.n == 0 {
+				return y
+			} else if y.n == 0 {
+				return x
+			}
+
+			n := x.n + y.n
+			d := y.mean - x.mean
+
+			return welford{
+				n:    n,
+				mean: x.mean + d*float64(y.n)/float64(n),
+				m2:   x.m2 + y.m2 + d*d*float64(x.n)*float64(y.n)/float64(n),
+			}
+		},
+	}
+}
+
+// variance returns the variance held by a welford accumulator
+// with ddof delta degrees of freedom, or NaN if ddof is too large.
+func variance(acc welford, ddof int) float64 {
+	if acc.n-ddof <= 0 {
+		return math.NaN()
+	}
+
+	return acc.m2 / float64(acc.n-ddof)
+}
+
+// Var returns the variance of all elements in the Tensor.
+// The divisor used is n - ddof, where n is the number of elements,
+// so a ddof of 0 computes the population variance and a ddof of 1
+// computes the sample variance.
+func (t Tensor[T]) Var(ddof int) Tensor[float64] {
+	return t.VarAxis(ddof, false)
+}
+
+// VarAxis returns the variance of the Tensor's elements
+// along the given axes, with ddof delta degrees of freedom.
+func (t Tensor[T]) VarAxis(ddof int, keepdim bool, axes ...int) Tensor[float64] {
+	return foldAxis("VarAxis", t, varReducer[T](), func(acc welford) float64 {
+		return variance(acc, ddof)
+	}, keepdim, axes...)
+}
+
+// Std returns the standard deviation of all elements in the Tensor,
+// with ddof delta degrees of freedom.
+func (t Tensor[T]) Std(ddof int) Tensor[float64] {
+	return t.StdAxis(ddof, false)
+}
+
+// StdAxis returns the standard deviation of the Tensor's elements
+// along the given axes, with ddof delta degrees of freedom.
+func (t Tensor[T]) StdAxis(ddof int, keepdim bool, axes ...int) Tensor[float64] {
+	return foldAxis("StdAxis", t, varReducer[T](), func(acc welford) float64 {
+		return math.Sqrt(variance(acc, ddof))
+	}, keepdim, axes...)
+}
+
+// collectReducer returns a Reducer collecting the elements as float64s.
+func collectReducer[T Number]() Reducer[T, []float64] {
+	return Reducer[T, []float64]{
+		Identity: func() []float64 { return nil },
+		Chunk: func(acc []float64, s []T) []float64 {
+			for _, x := range s {
+				acc = append(acc, float64(x))
+			}
+			return acc
+		},
+		Combine: func(x, y []float64) []float64 {
+			return append(x, y...)
+		},
+	}
+}
+
+// quantileOf returns the q-th quantile of the given elements,
+// sorting them in place.
+func quantileOf(s []float64, q float64, method Interpolation) float64 {
+	sort.Float64s(s)
+
+	pos := q * float64(len(s)-1)
+	i := int(math.Floor(pos))
+	j := int(math.Ceil(pos))
+	f := pos - float64(i)
+
+	switch method {
+	case InterpLower:
+		return s[i]
+	case InterpHigher:
+		return s[j]
+	case InterpNearest:
+		return s[int(math.RoundToEven(pos))]
+	case InterpMidpoint:
+		return (s[i] + s[j]) / 2
+	default:
+		return s[i] + f*(s[j]-s[i])
+	}
+}
+
+// Quantile returns the q-th quantile of all elements in the Tensor,
+// where q is within [0, 1], using the given interpolation method
+// when the quantile lies between two elements.
+func (t Tensor[T]) Quantile(q float64, method Interpolation) Tensor[float64] {
+	return t.QuantileAxis(q, method, false)
+}
+
+// QuantileAxis returns the q-th quantile of the Tensor's elements
+// along the given axes, where q is within [0, 1].
+func (t Tensor[T]) QuantileAxis(q float64, method Interpolation, keepdim bool, axes ...int) Tensor[float64] {
+	if t.Err != nil {
+		if EnvConfig.Interactive {
+			panic(t.Err)
+		} else {
+			return Tensor[float64]{
+				Err: t.Err,
+			}
+		}
+	}
+
+	err := verifyGoodQuantile(q, method)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			return Tensor[float64]{
+				Err: err,
+			}
+		}
+	}
+
+	return foldAxis("QuantileAxis", t, collectReducer[T](), func(acc []float64) float64 {
+		return quantileOf(acc, q, method)
+	}, keepdim, axes...)
+}
+
+// Percentile returns the p-th percentile of all elements in the Tensor,
+// where p is within [0, 100].
+func (t Tensor[T]) Percentile(p float64, method Interpolation) Tensor[float64] {
+	return t.QuantileAxis(p/100, method, false)
+}
+
+// PercentileAxis returns the p-th percentile of the Tensor's elements
+// along the given axes, where p is within [0, 100].
+func (t Tensor[T]) PercentileAxis(p float64, method Interpolation, keepdim bool, axes ...int) Tensor[float64] {
+	return t.QuantileAxis(p/100, method, keepdim, axes...)
+}
+
+// Median returns the median of all elements in the Tensor.
+func (t Tensor[T]) Median() Tensor[float64] {
+	return t.QuantileAxis(0.5, InterpLinear, false)
+}
+
+// MedianAxis returns the median of the Tensor's elements
+// along the given axes.
+func (t Tensor[T]) MedianAxis(keepdim bool, axes ...int) Tensor[float64] {
+	return t.QuantileAxis(0.5, InterpLinear, keepdim, axes...)
+}
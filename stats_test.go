@@ -0,0 +1,86 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestVar(t *testing.T) {
+	defer func(n int) { nune.EnvConfig.NumCPU = n }(nune.EnvConfig.NumCPU)
+
+	tensor := nune.From[int]([]int{2, 4, 4, 4, 5, 5, 7, 9})
+
+	for _, n := range []int{1, 3, 8} {
+		nune.EnvConfig.NumCPU = n
+
+		if v := tensor.Var(0).Scalar(); math.Abs(v-4) > 1e-12 {
+			t.Errorf("population variance with %d cpus is %v instead of 4", n, v)
+		}
+
+		if s := tensor.Std(1).Scalar(); math.Abs(s-math.Sqrt(32.0/7)) > 1e-12 {
+			t.Errorf("sample standard deviation with %d cpus is %v", n, s)
+		}
+	}
+
+	rows := nune.Range[int](0, 6, 1).Reshape(2, 3).VarAxis(0, true, 1)
+	if !slices.Equal(rows.Shape(), []int{2, 1}) {
+		t.Error("variance along axis 1 did not keep the reduced axis")
+	}
+
+	for _, v := range rows.Ravel() {
+		if math.Abs(v-2.0/3) > 1e-12 {
+			t.Error("variance along axis 1 is incorrect")
+		}
+	}
+
+	if !math.IsNaN(nune.From[int](1).Var(1).Scalar()) {
+		t.Error("variance with too many degrees of freedom is not NaN")
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	tensor := nune.From[int]([]int{4, 1, 3, 2})
+
+	if tensor.Median().Scalar() != 2.5 {
+		t.Error("median is incorrect")
+	}
+
+	methods := map[nune.Interpolation]float64{
+		nune.InterpLinear:   1.75,
+		nune.InterpLower:    1,
+		nune.InterpHigher:   2,
+		nune.InterpNearest:  2,
+		nune.InterpMidpoint: 1.5,
+	}
+	for method, expected := range methods {
+		if q := tensor.Quantile(0.25, method).Scalar(); q != expected {
+			t.Errorf("quantile with method %d is %v instead of %v", method, q, expected)
+		}
+	}
+
+	if tensor.Percentile(100, nune.InterpLinear).Scalar() != 4 {
+		t.Error("100th percentile is not the maximum")
+	}
+
+	if tensor.Quantile(1.5, nune.InterpLinear).Err == nil {
+		t.Error("computed a quantile out of bounds")
+	}
+
+	cols := tensor.Reshape(2, 2).MedianAxis(false, 0)
+	if !slices.Equal(cols.Ravel(), []float64{3.5, 1.5}) {
+		t.Error("median along axis 0 is incorrect")
+	}
+}
+
+func BenchmarkVar(b *testing.B) {
+	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
+		tensor.Var(0)
+	})
+}
@@ -129,6 +129,8 @@ func anyToTensor[T Number](a any) (Tensor[T], bool) {
 		return Cast[T](a.(Tensor[float32])), true
 	case Tensor[float64]:
 		return Cast[T](a.(Tensor[float64])), true
+	case Tensor[Bool]:
+		return Cast[T](a.(Tensor[Bool])), true
 	default:
 		return Tensor[T]{}, false
 	}
@@ -7,6 +7,7 @@ package nune
 import (
 	"math"
 	"runtime"
+	"sync"
 	"github.com/vorduin/slices"
 )
 
@@ -46,4 +47,300 @@ func configCPU(size int) int {
 	} else {
 		return runtime.NumCPU()
 	}
-}
\ No newline at end of file
+}
+
+// walkLayout calls f with the data buffer position of every element
+// of the given layout whose row-major index lies within [start, end).
+func walkLayout(shape, stride []int, offset, start, end int, f func(pos int)) {
+	if start >= end {
+		return
+	}
+
+	if len(shape) == 0 {
+		f(offset)
+		return
+	}
+
+	coords := slices.WithLen[int](len(shape))
+	pos := offset
+	rem := start
+	for i := len(shape) - 1; i >= 0; i-- {
+		coords[i] = rem % shape[i]
+		rem /= shape[i]
+		pos += coords[i] * stride[i]
+	}
+
+	last := len(shape) - 1
+	for n := start; n < end; n++ {
+		f(pos)
+
+		for i := last; i >= 0; i-- {
+			coords[i]++
+			pos += stride[i]
+			if coords[i] < shape[i] {
+				break
+			}
+			pos -= coords[i] * stride[i]
+			coords[i] = 0
+		}
+	}
+}
+
+// isContiguous returns whether or not the given layout
+// is a compact row-major view over its data buffer.
+func isContiguous(shape, stride []int) bool {
+	expected := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		if shape[i] != 1 && stride[i] != expected {
+			return false
+		}
+		expected *= shape[i]
+	}
+
+	return true
+}
+
+// gather returns the Tensor's elements whose row-major index lies
+// within [start, end), either as a view into its data buffer if the
+// Tensor is contiguous, or copied into the given buffer otherwise.
+// The buffer is allocated if it isn't large enough.
+func (t Tensor[T]) gather(start, end int, buf []T) []T {
+	if isContiguous(t.shape, t.stride) {
+		return t.data[t.offset+start : t.offset+end]
+	}
+
+	if cap(buf) < end-start {
+		buf = make([]T, end-start)
+	}
+	buf = buf[:end-start]
+	i := 0
+	walkLayout(t.shape, t.stride, t.offset, start, end, func(pos int) {
+		buf[i] = t.data[pos]
+		i++
+	})
+
+	return buf
+}
+
+// broadcastShapes returns the shape both given shapes broadcast to,
+// aligning them from their last axis, and whether or not they
+// are broadcastable together.
+func broadcastShapes(s1, s2 []int) ([]int, bool) {
+	if len(s1) < len(s2) {
+		s1, s2 = s2, s1
+	}
+
+	shape := slices.Clone(s1)
+	if shape == nil {
+		shape = []int{}
+	}
+
+	for i := range s2 {
+		j := len(s1) - len(s2) + i
+		if shape[j] == 1 {
+			shape[j] = s2[i]
+		} else if s2[i] != 1 && s2[i] != shape[j] {
+			return nil, false
+		}
+	}
+
+	return shape, true
+}
+
+// materialize returns a copy of the Tensor's elements in a new
+// contiguous data buffer, laid out with the given shape, which
+// must hold the same number of elements as the Tensor.
+func (t Tensor[T]) materialize(shape ...int) Tensor[T] {
+	data := t.gather(0, t.Numel(), nil)
+	if isContiguous(t.shape, t.stride) {
+		data = slices.Clone(data)
+	}
+
+	if len(shape) == 0 {
+		shape = nil
+	}
+
+	return Tensor[T]{
+		data:   data,
+		shape:  slices.Clone(shape),
+		stride: configStride(shape),
+	}
+}
+
+// walkLayouts calls f with the data buffer positions of every element,
+// within each of the given layouts sharing the same shape, whose row-major
+// index lies within [start, end). The positions slice is reused between calls.
+func walkLayouts(shape []int, strides [][]int, offsets []int, start, end int, f func(pos []int)) {
+	if start >= end {
+		return
+	}
+
+	pos := slices.Clone(offsets)
+
+	if len(shape) == 0 {
+		f(pos)
+		return
+	}
+
+	coords := slices.WithLen[int](len(shape))
+	rem := start
+	for i := len(shape) - 1; i >= 0; i-- {
+		coords[i] = rem % shape[i]
+		rem /= shape[i]
+		for l := range pos {
+			pos[l] += coords[i] * strides[l][i]
+		}
+	}
+
+	last := len(shape) - 1
+	for n := start; n < end; n++ {
+		f(pos)
+
+		for i := last; i >= 0; i-- {
+			coords[i]++
+			for l := range pos {
+				pos[l] += strides[l][i]
+			}
+			if coords[i] < shape[i] {
+				break
+			}
+			for l := range pos {
+				pos[l] -= coords[i] * strides[l][i]
+			}
+			coords[i] = 0
+		}
+	}
+}
+
+// handleLayouts processes an operation over every element of the given
+// layouts sharing the same shape, splitting them across goroutines.
+func handleLayouts(shape []int, strides [][]int, offsets []int, f func(pos []int), nCPU int) {
+	size := 1
+	for _, d := range shape {
+		size *= d
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < nCPU; i++ {
+		min := (i * size / nCPU)
+		max := ((i + 1) * size) / nCPU
+
+		wg.Add(1)
+		go func(min, max int) {
+			walkLayouts(shape, strides, offsets, min, max, f)
+
+			wg.Done()
+		}(min, max)
+	}
+
+	wg.Wait()
+}
+
+// position returns the data buffer position of the
+// element at the given row-major index of the Tensor.
+func (t Tensor[T]) position(idx int) int {
+	pos := t.offset
+	for i := len(t.shape) - 1; i >= 0; i-- {
+		pos += (idx % t.shape[i]) * t.stride[i]
+		idx /= t.shape[i]
+	}
+
+	return pos
+}
+
+// broadcastStride returns the Tensor's strides once broadcast to the
+// given shape, aligned from the last axis, with a null stride for
+// every axis along which the Tensor is repeated.
+func (t Tensor[T]) broadcastStride(shape []int) []int {
+	stride := slices.WithLen[int](len(shape))
+	for i := range t.shape {
+		j := len(shape) - len(t.shape) + i
+		if t.shape[i] == shape[j] {
+			stride[j] = t.stride[i]
+		}
+	}
+
+	return stride
+}
+
+// inferShape returns the given shape with its -1 dimension, if any,
+// inferred so that the shape holds the given number of elements.
+func inferShape(numel int, shape []int) ([]int, error) {
+	if len(shape) == 0 {
+		if numel != 1 {
+			return nil, ErrNumelMismatch
+		}
+		return nil, nil
+	}
+
+	shape = slices.Clone(shape)
+
+	inferred, known := -1, 1
+	for i, d := range shape {
+		switch {
+		case d == -1 && inferred < 0:
+			inferred = i
+		case d <= 0:
+			return nil, ErrBadShape
+		default:
+			known *= d
+		}
+	}
+
+	if inferred >= 0 {
+		if numel%known != 0 {
+			return nil, ErrNumelMismatch
+		}
+		shape[inferred] = numel / known
+	} else if known != numel {
+		return nil, ErrNumelMismatch
+	}
+
+	return shape, nil
+}
+
+// viewStride returns the stride with which the given layout can be viewed
+// with the new shape, holding the same number of elements, and whether or
+// not such a view exists. Each group of contiguous axes of the layout must
+// be split or merged into a group of axes of the new shape.
+func viewStride(shape, stride, newShape []int) ([]int, bool) {
+	if len(newShape) == 0 {
+		return nil, true
+	}
+
+	if len(shape) == 0 {
+		return configStride(newShape), true
+	}
+
+	newStride := slices.WithLen[int](len(newShape))
+
+	d := len(newShape) - 1
+	base := stride[len(stride)-1]
+	numel, newNumel := 1, 1
+
+	for i := len(shape) - 1; i >= 0; i-- {
+		numel *= shape[i]
+
+		// the group ends when the previous axis isn't
+		// contiguous with the ones that follow it
+		if i == 0 || shape[i-1] != 1 && stride[i-1] != numel*base {
+			for d >= 0 && (newNumel < numel || newShape[d] == 1) {
+				newStride[d] = newNumel * base
+				newNumel *= newShape[d]
+				d--
+			}
+
+			if newNumel != numel {
+				return nil, false
+			}
+
+			if i > 0 {
+				base = stride[i-1]
+				numel, newNumel = 1, 1
+			}
+		}
+	}
+
+	return newStride, d == -1
+}
@@ -4,7 +4,11 @@
 
 package nune
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math"
+)
 
 // List of errors.
 var (
@@ -13,7 +17,8 @@ var (
 	ErrBadShape = errors.New("nune: received a bad shape")
 
 	// ErrBadStep occurs when a step size is null or
-	// is opposite to the inverval's order.
+	// is opposite to the inverval's order, or when a fractional
+	// step or its interval is empty or isn't finite.
 	ErrBadStep = errors.New("nune: received a bad step size")
 
 	// ErrNotBroadable occurs when a Tensor could not be broadcast
@@ -38,11 +43,140 @@ var (
 	// (0, rank) bounds.
 	ErrAxisBounds = errors.New("nune: axis out of bounds")
 
+	// ErrRepeatedAxis occurs when the same axis is provided
+	// more than once to a function that expects distinct axes.
+	ErrRepeatedAxis = errors.New("nune: received a repeated axis")
+
+	// ErrBadPermutation occurs when a permutation doesn't
+	// hold as many axes as the Tensor's rank.
+	ErrBadPermutation = errors.New("nune: received a bad permutation")
+
+	// ErrShapeMismatch occurs when the shapes of two Tensors
+	// are incompatible for the requested operation.
+	ErrShapeMismatch = errors.New("nune: tensors' shapes do not match")
+
+	// ErrBadSubscripts occurs when einsum subscripts are malformed,
+	// or don't match the operands they describe.
+	ErrBadSubscripts = errors.New("nune: received bad einsum subscripts")
+
+	// ErrBadQuantile occurs when a quantile is out of [0, 1] bounds,
+	// or when its interpolation method is unknown.
+	ErrBadQuantile = errors.New("nune: received a bad quantile")
+
+	// ErrBadSlicing occurs when a slicing is malformed,
+	// or holds more than one ellipsis.
+	ErrBadSlicing = errors.New("nune: received a bad slicing")
+
+	// ErrBroadcastWrite occurs when writing to a broadcast view,
+	// several elements of which share the same position in the
+	// data buffer.
+	ErrBroadcastWrite = errors.New("nune: cannot write to a broadcast view")
+
+	// ErrNumelMismatch occurs when a Tensor is reshaped to a shape
+	// that doesn't hold the same number of elements.
+	ErrNumelMismatch = errors.New("nune: shape does not hold the tensor's number of elements")
+
+	// ErrViewCopy occurs when a Tensor can't be viewed
+	// with a shape without being copied.
+	ErrViewCopy = errors.New("nune: could not view tensor with shape without copying it")
+
+	// ErrBadSplit occurs when a Tensor is split into pieces whose
+	// sizes are not positive or don't add up to the axis's dimensions.
+	ErrBadSplit = errors.New("nune: received bad split sizes")
+
+	// ErrBadRepeats occurs when repeat counts aren't positive, or
+	// when per-entry counts don't match the repeated axis.
+	ErrBadRepeats = errors.New("nune: received bad repeat counts")
+
+	// ErrBadPadding occurs when padding widths are negative or
+	// don't match the Tensor's rank, when the padding mode is unknown,
+	// or when an empty axis is padded with its own elements.
+	ErrBadPadding = errors.New("nune: received a bad padding")
+
+	// ErrNoElements occurs when an operation selects no elements,
+	// since a Tensor can't have axes of null dimensions.
+	ErrNoElements = errors.New("nune: operation selected no elements")
+
+	// ErrBadIndexing occurs when a grid's indexing is unknown.
+	ErrBadIndexing = errors.New("nune: received an unknown indexing")
+
+	// ErrNoOperands occurs when a function that
+	// takes several Tensors receives none.
+	ErrNoOperands = errors.New("nune: received no tensors")
+
 	// ErrStorageDump occurs when the Assign method fails to dump
 	// the given data to the Tensor's storage.
 	ErrStorageDump = errors.New("nune: could not dump data buffer to storage")
 )
 
+// An IndexError occurs when an index, such as one held by an index Tensor,
+// is out of the bounds of the axis it indexes.
+type IndexError struct {
+	Index int // the offending index
+	Axis  int // the indexed axis, or -1 if the Tensor is indexed as flattened
+	Size  int // the indexed axis's dimensions
+}
+
+// Error returns the IndexError's description.
+func (e *IndexError) Error() string {
+	if e.Axis < 0 {
+		return fmt.Sprintf("nune: index %d out of bounds for flattened tensor of size %d", e.Index, e.Size)
+	}
+	return fmt.Sprintf("nune: index %d out of bounds for axis %d of size %d", e.Index, e.Axis, e.Size)
+}
+
+// verifyWritable makes sure no two elements of a layout
+// share the same position in its data buffer, as is
+// the case along the broadcast axes of a view.
+func verifyWritable(shape, stride []int) error {
+	for i := range shape {
+		if shape[i] > 1 && stride[i] == 0 {
+			return ErrBroadcastWrite
+		}
+	}
+	return nil
+}
+
+// An AxisError occurs when a method receives an axis that is out of
+// the bounds of the Tensor's rank, or that is repeated.
+type AxisError struct {
+	Method string // the method that received the axis
+	Axis   int    // the offending axis, as received
+	Rank   int    // the Tensor's rank
+	Err    error  // either ErrAxisBounds or ErrRepeatedAxis
+}
+
+// Error returns the AxisError's description.
+func (e *AxisError) Error() string {
+	if e.Err == ErrRepeatedAxis {
+		return fmt.Sprintf("nune: %s received repeated axis %d for tensor of rank %d", e.Method, e.Axis, e.Rank)
+	}
+	return fmt.Sprintf("nune: %s received axis %d out of bounds for tensor of rank %d", e.Method, e.Axis, e.Rank)
+}
+
+// Unwrap returns the error the AxisError is an instance of.
+func (e *AxisError) Unwrap() error {
+	return e.Err
+}
+
+// An OperandError occurs when one of the Tensors received
+// by a function is incompatible with the others.
+type OperandError struct {
+	Method  string // the function that received the operand
+	Operand int    // the position of the offending operand
+	Err     error  // the reason the operand is incompatible
+}
+
+// Error returns the OperandError's description.
+func (e *OperandError) Error() string {
+	return fmt.Sprintf("nune: %s received incompatible operand %d: %v", e.Method, e.Operand, e.Err)
+}
+
+// Unwrap returns the reason the operand is incompatible.
+func (e *OperandError) Unwrap() error {
+	return e.Err
+}
+
 // verifyGoodShape makes sure a shape isn't empty,
 // and that none of the shapes axes's dimensions
 // are less than or equal to zero, and panics otherwise.
@@ -71,6 +205,24 @@ func verifyGoodStep(s, start, end int) error {
 	return nil
 }
 
+// verifyGoodFloatStep makes sure a fractional step size and its interval
+// are finite, that the interval isn't empty, and that the step size
+// isn't null and matches the interval's order.
+func verifyGoodFloatStep(s, start, end float64) error {
+	for _, x := range []float64{s, start, end} {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return ErrBadStep
+		}
+	}
+
+	if s == 0 || start == end {
+		return ErrBadStep
+	} else if s > 0 && end < start || s < 0 && end > start {
+		return ErrBadStep
+	}
+	return nil
+}
+
 // verifyGoodInterval makes sure the interval is not null,
 // is ascending, and falls within the given limits, inclusive.
 // A value of nil for min or max means there is no limit.
@@ -102,11 +254,112 @@ func verifyArgsBounds(nargs, max int) error {
 	return nil
 }
 
-// verifyAxisBounds makes sure an axis is strictly positive
-// and is less than the Tensor's rank.
-func verifyAxisBounds(axis, rank int) error {
-	if axis < 0 || axis > rank {
-		return ErrAxisBounds
+// normAxis returns the given axis within [0, rank) bounds, counting
+// from the last axis if it's negative, or an AxisError naming the given
+// method if it's out of [-rank, rank) bounds.
+func normAxis(method string, axis, rank int) (int, error) {
+	n := axis
+	if n < 0 {
+		n += rank
+	}
+
+	if n < 0 || n >= rank {
+		return 0, &AxisError{
+			Method: method,
+			Axis:   axis,
+			Rank:   rank,
+			Err:    ErrAxisBounds,
+		}
+	}
+
+	return n, nil
+}
+
+// normInsertAxis returns the given axis at which a new axis is inserted
+// within [0, rank] bounds, counting from the end if it's negative, or an
+// AxisError naming the given method and rank if it's out of [-rank-1, rank]
+// bounds.
+func normInsertAxis(method string, axis, rank int) (int, error) {
+	n, err := normAxis(method, axis, rank+1)
+	if err != nil {
+		err.(*AxisError).Rank = rank
+	}
+
+	return n, err
+}
+
+// normAxes returns the given axes normalized as normAxis does,
+// or an AxisError naming the given method if any of them
+// is out of bounds or repeated.
+func normAxes(method string, rank int, axes ...int) ([]int, error) {
+	norm := make([]int, len(axes))
+	seen := make([]bool, rank)
+
+	for i, axis := range axes {
+		n, err := normAxis(method, axis, rank)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[n] {
+			return nil, &AxisError{
+				Method: method,
+				Axis:   axis,
+				Rank:   rank,
+				Err:    ErrRepeatedAxis,
+			}
+		}
+
+		seen[n] = true
+		norm[i] = n
+	}
+
+	return norm, nil
+}
+
+// verifyGoodQuantile makes sure a quantile is within [0, 1]
+// bounds and that its interpolation method is known.
+func verifyGoodQuantile(q float64, method Interpolation) error {
+	if !(q >= 0 && q <= 1) {
+		return ErrBadQuantile
+	}
+	if method < InterpLinear || method > InterpMidpoint {
+		return ErrBadQuantile
+	}
+	return nil
+}
+
+// verifyGoodPadding makes sure there are no more pairs of padding
+// widths than axes in the shape, that the widths aren't negative,
+// that the padding mode is known, and that empty axes are padded
+// with the constant mode only.
+func verifyGoodPadding(shape []int, widths [][2]int, mode PadMode) error {
+	if len(widths) > len(shape) {
+		return ErrBadPadding
+	}
+	if mode < PadConstant || mode > PadCircular {
+		return ErrBadPadding
+	}
+
+	for i, w := range widths {
+		if w[0] < 0 || w[1] < 0 {
+			return ErrBadPadding
+		}
+		if mode != PadConstant && w[0]+w[1] > 0 && shape[len(shape)-len(widths)+i] == 0 {
+			return ErrBadPadding
+		}
+	}
+
+	return nil
+}
+
+// verifyIndices makes sure every index held by the index
+// Tensor is within [0, size) bounds for the given axis.
+func verifyIndices(idx Tensor[int], axis, size int) error {
+	for _, i := range idx.gather(0, idx.Numel(), nil) {
+		if i < 0 || i >= size {
+			return &IndexError{Index: i, Axis: axis, Size: size}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,328 @@
+// Copyright © The Nune Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nune_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
+)
+
+func TestContiguous(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	if !tensor.IsContiguous() || !tensor.Index(1).IsContiguous() {
+		t.Error("contiguous tensor is reported as non-contiguous")
+	}
+
+	view := tensor.Permute(1, 0)
+	if view.IsContiguous() {
+		t.Error("permuted tensor is reported as contiguous")
+	}
+
+	compact := view.Contiguous()
+	if !compact.IsContiguous() || !slices.Equal(compact.Ravel(), []int{0, 3, 1, 4, 2, 5}) {
+		t.Error("contiguous copy of a view is incorrect")
+	}
+
+	clone := view.Get(nune.All, nune.At(1)).Clone()
+	if !slices.Equal(clone.Ravel(), []int{3, 4, 5}) || !slices.Equal(clone.Stride(), []int{1}) {
+		t.Error("clone of a view is incorrect")
+	}
+
+	cast := nune.Cast[float64](view.Get(nune.S(1, 3)))
+	if !slices.Equal(cast.Ravel(), []float64{1, 4, 2, 5}) {
+		t.Error("cast of a view is incorrect")
+	}
+}
+
+func TestMapView(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	// only the second column is mapped
+	tensor.Get(nune.All, nune.At(1)).Map(func(x int) int {
+		return -x
+	})
+
+	if !slices.Equal(tensor.Ravel(), []int{0, -1, 2, 3, -4, 5}) {
+		t.Error("map over a view is incorrect")
+	}
+}
+
+func TestReverseFlipView(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	tensor.Permute(1, 0).Reverse()
+	if !slices.Equal(tensor.Ravel(), []int{5, 4, 3, 2, 1, 0}) {
+		t.Error("reverse of a view is incorrect")
+	}
+
+	tensor = nune.Range[int](0, 12, 1).Reshape(2, 3, 2)
+
+	tensor.Flip(1)
+	if !slices.Equal(tensor.Ravel(), []int{4, 5, 2, 3, 0, 1, 10, 11, 8, 9, 6, 7}) {
+		t.Error("flip along an inner axis is incorrect")
+	}
+
+	tensor.Get(nune.At(0)).Flip(1)
+	if !slices.Equal(tensor.Ravel(), []int{5, 4, 3, 2, 1, 0, 10, 11, 8, 9, 6, 7}) {
+		t.Error("flip of a view is incorrect")
+	}
+}
+
+func TestBroadcastView(t *testing.T) {
+	bias := nune.FromBuffer([]int{1, 2, 3})
+
+	view := bias.Broadcast(2, 3)
+	if !slices.Equal(view.Stride(), []int{0, 1}) {
+		t.Error("broadcast view does not have a null stride")
+	}
+
+	if !slices.Equal(elements(view), []int{1, 2, 3, 1, 2, 3}) {
+		t.Error("broadcast view is incorrect")
+	}
+
+	if view.Assign(0).Err == nil || view.Map(func(x int) int { return x }).Err == nil {
+		t.Error("wrote to a broadcast view")
+	}
+
+	// the bias is broadcast along the rows without being copied
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3).Add(bias)
+	if !slices.Equal(tensor.Ravel(), []int{1, 3, 5, 4, 6, 8}) {
+		t.Error("addition of a broadcast row is incorrect")
+	}
+
+	// this tensor needs to be broadcast, so the result is a new tensor
+	col := nune.FromBuffer([]int{10, 20}).Reshape(2, 1)
+	sum := col.Add(bias)
+	if !slices.Equal(sum.Ravel(), []int{11, 12, 13, 21, 22, 23}) || !slices.Equal(col.Ravel(), []int{10, 20}) {
+		t.Error("addition of broadcast operands is incorrect")
+	}
+
+	if tensor.Add(nune.Zeros[int](2)).Err == nil {
+		t.Error("added tensors of incompatible shapes")
+	}
+}
+
+func TestReshape(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, -1, 4)
+	if !slices.Equal(tensor.Shape(), []int{2, 3, 4}) {
+		t.Error("reshape did not infer the missing dimension")
+	}
+
+	if tensor.Reshape(5, -1).Err == nil || tensor.Reshape(-1, -1).Err == nil || tensor.Reshape(2, 3).Err == nil {
+		t.Error("reshaped to a shape with a different number of elements")
+	}
+
+	// merging the two trailing axes of a sliced view is possible,
+	// while merging the two leading axes requires a copy
+	view := tensor.Get(nune.All, nune.S(1, 3))
+	merged := view.Reshape(2, 8)
+	if !slices.Equal(merged.Stride(), []int{12, 1}) || merged.Offset() != 4 {
+		t.Error("reshape of a sliced view copied it")
+	}
+
+	merged = view.Reshape(4, 4)
+	if !slices.Equal(merged.Ravel(), []int{4, 5, 6, 7, 8, 9, 10, 11, 16, 17, 18, 19, 20, 21, 22, 23}) {
+		t.Error("reshape of a sliced view is incorrect")
+	}
+
+	// a permuted tensor can't be flattened without a copy
+	permuted := tensor.Permute(2, 1, 0)
+	flat := permuted.Reshape(-1)
+	if !flat.IsContiguous() || !slices.Equal(flat.Ravel()[:6], []int{0, 12, 4, 16, 8, 20}) {
+		t.Error("reshape of a permuted tensor is incorrect")
+	}
+
+	if permuted.View(-1).Err == nil {
+		t.Error("viewed a permuted tensor with a shape that requires a copy")
+	}
+
+	split := permuted.View(2, 2, 3, 2)
+	if split.Err != nil || !slices.Equal(split.Stride(), []int{2, 1, 4, 12}) {
+		t.Error("view splitting an axis of a permuted tensor is incorrect")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	flat := tensor.Flatten(1, 2)
+	if !slices.Equal(flat.Shape(), []int{2, 12}) {
+		t.Error("flatten of the trailing axes has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.Flatten(0, 2).Shape(), []int{24}) {
+		t.Error("flatten of all axes has the wrong shape")
+	}
+
+	if tensor.Flatten(2, 1).Err == nil || tensor.Flatten(0, 3).Err == nil {
+		t.Error("flattened bad axes")
+	}
+
+	unflat := flat.Unflatten(1, -1, 2, 2)
+	if !slices.Equal(unflat.Shape(), []int{2, 3, 2, 2}) {
+		t.Error("unflatten has the wrong shape")
+	}
+
+	if flat.Unflatten(1, 5, -1).Err == nil {
+		t.Error("unflattened an axis to sizes that don't match it")
+	}
+}
+
+func TestNegativeAxes(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	if tensor.Size(-1) != 4 || tensor.Size(-3) != 2 {
+		t.Error("size of a negative axis is incorrect")
+	}
+
+	if !slices.Equal(tensor.Unsqueeze(-1).Shape(), []int{2, 3, 4, 1}) {
+		t.Error("unsqueeze of the last axis has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.Unsqueeze(-1).Squeeze(-1).Shape(), []int{2, 3, 4}) {
+		t.Error("squeeze of the last axis has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.Permute(-1, 0, -2).Shape(), []int{4, 2, 3}) {
+		t.Error("permute with negative axes has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.Cat(tensor, -1).Shape(), []int{2, 3, 8}) {
+		t.Error("concatenation along the last axis has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.Stack(tensor, -1).Shape(), []int{2, 3, 4, 2}) {
+		t.Error("stack along a new last axis has the wrong shape")
+	}
+
+	if !slices.Equal(tensor.SumAxis(false, -1, -3).Ravel(), []int{60, 92, 124}) {
+		t.Error("sum along negative axes is incorrect")
+	}
+
+	if !slices.Equal(tensor.Flatten(-2, -1).Shape(), []int{2, 12}) {
+		t.Error("flatten of negative axes has the wrong shape")
+	}
+
+	flipped := nune.Range[int](0, 4, 1).Reshape(2, 2).Flip(-1)
+	if !slices.Equal(flipped.Ravel(), []int{1, 0, 3, 2}) {
+		t.Error("flip along the last axis is incorrect")
+	}
+}
+
+func TestAxisErrors(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	var err *nune.AxisError
+	if !errors.As(tensor.Squeeze(3).Err, &err) || err.Method != "Squeeze" || err.Axis != 3 || err.Rank != 3 {
+		t.Error("squeeze of an out of bounds axis does not report it")
+	}
+
+	if !errors.Is(tensor.Cat(tensor, -4).Err, nune.ErrAxisBounds) {
+		t.Error("concatenated along an out of bounds axis")
+	}
+
+	if tensor.Unsqueeze(4).Err == nil || tensor.Unsqueeze(-4).Err != nil {
+		t.Error("unsqueeze does not accept axes within [-rank-1, rank]")
+	}
+
+	if !errors.As(tensor.Unsqueeze(5).Err, &err) || err.Rank != 3 {
+		t.Error("unsqueeze of an out of bounds axis reports the wrong rank")
+	}
+
+	if !errors.As(nune.StackN(5, tensor, tensor).Err, &err) || err.Rank != 3 || err.Method != "StackN" {
+		t.Error("stack along an out of bounds axis reports the wrong rank")
+	}
+
+	if !errors.As(tensor.MaxAxis(false, 1, -2).Err, &err) || err.Method != "MaxAxis" || !errors.Is(err, nune.ErrRepeatedAxis) {
+		t.Error("reduction along a repeated axis does not report it")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	swapped := tensor.Transpose(0, -1)
+	if !slices.Equal(swapped.Shape(), []int{4, 3, 2}) || swapped.IsContiguous() {
+		t.Error("transpose has the wrong layout")
+	}
+
+	if !slices.Equal(elements(swapped), elements(tensor.SwapAxes(-1, 0))) {
+		t.Error("swapaxes differs from transpose")
+	}
+
+	moved := tensor.MoveAxis(0, -1)
+	if !slices.Equal(moved.Shape(), []int{3, 4, 2}) || !slices.Equal(elements(moved.Index(1, 2)), []int{6, 18}) {
+		t.Error("moveaxis has the wrong layout")
+	}
+
+	if !slices.Equal(moved.MoveAxis(-1, 0).Shape(), tensor.Shape()) {
+		t.Error("moveaxis back does not restore the shape")
+	}
+
+	matrix := nune.Range[int](0, 6, 1).Reshape(2, 3)
+	if !slices.Equal(elements(matrix.T()), []int{0, 3, 1, 4, 2, 5}) {
+		t.Error("matrix transpose is incorrect")
+	}
+
+	vector := nune.Range[int](0, 3, 1)
+	if !slices.Equal(vector.T().Shape(), []int{3}) {
+		t.Error("vector transpose changes its shape")
+	}
+
+	swapped.Assign(0)
+	if !slices.Equal(tensor.Ravel(), make([]int, 24)) {
+		t.Error("transpose is not a view")
+	}
+}
+
+func TestPermuteErrors(t *testing.T) {
+	tensor := nune.Range[int](0, 24, 1).Reshape(2, 3, 4)
+
+	if !errors.Is(tensor.Permute(1, 0).Err, nune.ErrBadPermutation) {
+		t.Error("permuted with missing axes")
+	}
+
+	if !errors.Is(tensor.Permute(2, 1, 0, 0).Err, nune.ErrBadPermutation) {
+		t.Error("permuted with extra axes")
+	}
+
+	var err *nune.AxisError
+	if !errors.As(tensor.Permute(0, 1, -3).Err, &err) || !errors.Is(err, nune.ErrRepeatedAxis) {
+		t.Error("permuted with a repeated axis")
+	}
+
+	if !errors.As(tensor.Transpose(0, 3).Err, &err) || err.Method != "Transpose" {
+		t.Error("transpose of an out of bounds axis does not report it")
+	}
+
+	if !errors.As(tensor.MoveAxis(-4, 0).Err, &err) || err.Method != "MoveAxis" {
+		t.Error("moveaxis of an out of bounds axis does not report it")
+	}
+}
+
+func TestIndexBounds(t *testing.T) {
+	tensor := nune.Range[int](0, 6, 1).Reshape(2, 3)
+
+	if tensor.Index(-1, -1).Scalar() != 5 {
+		t.Error("index of negative indices is incorrect")
+	}
+
+	var err *nune.IndexError
+	if !errors.As(tensor.Index(0, 3).Err, &err) || err.Index != 3 || err.Axis != 1 || err.Size != 3 {
+		t.Error("index past the end of an axis does not report it")
+	}
+
+	if !errors.As(tensor.Index(2).Err, &err) || err.Axis != 0 {
+		t.Error("index past the end of the first axis does not report it")
+	}
+
+	if !errors.As(tensor.Index(-3).Err, &err) || err.Index != -3 {
+		t.Error("negative index out of bounds does not report it")
+	}
+}
@@ -6,66 +6,45 @@ package nune
 
 import (
 	"sync"
+
 	"github.com/vorduin/slices"
 )
 
-// handleZip processes an elementwise operation accordingly.
+// handleZip processes an elementwise operation accordingly,
+// the rhs Tensor being broadcast to the lhs Tensor's shape.
 func handleZip[T Number](lhs, rhs Tensor[T], f func(T, T) T, nCPU int) {
-	if lhs.Rank() == 0 {
-		lhs.Ravel()[0] = f(lhs.Ravel()[0], rhs.Ravel()[0])
-	}
-
-	var wg sync.WaitGroup
+	if lhs.IsContiguous() && rhs.IsContiguous() && slices.Equal(lhs.shape, rhs.shape) {
+		l, r := lhs.Ravel(), rhs.Ravel()
 
-	for i := 0; i < nCPU; i++ {
-		min := (i * lhs.Size(0) / nCPU)
-		max := ((i + 1) * lhs.Size(0)) / nCPU
+		var wg sync.WaitGroup
 
-		wg.Add(1)
-		go func(t1, t2 Tensor[T]) {
-			it1, it2 := t1.Iter(), t2.Iter()
-			for j := 0; j < it1.Size(); j++ {
-				n1, _ := it1.Next()
-				n2, _ := it2.Next()
-
-				n1.Ravel()[0] = f(n1.Ravel()[0], n2.Ravel()[0])
-			}
-
-			wg.Done()
-		}(lhs.Slice(min, max), rhs.Slice(min, max))
-	}
+		for i := 0; i < nCPU; i++ {
+			min := (i * len(l) / nCPU)
+			max := ((i + 1) * len(l)) / nCPU
 
-	wg.Wait()
-}
+			wg.Add(1)
+			go func(lBuf, rBuf []T) {
+				for j := range lBuf {
+					lBuf[j] = f(lBuf[j], rBuf[j])
+				}
 
-// midwayBroadcast adjusts the first shape so that the second shape might
-// be broadcastable to it. For example, for the shape [4, 1] to be
-// broadcastable with the shape [3], this function returns that
-// the broadcasting shape should be [4, 3].
-func midwayBroadcast(s1, s2 []int) []int {
-	var s []int
-
-	if len(s1) < len(s2) {
-		s = slices.WithLen[int](len(s2))
-		for i := 0; i < len(s2)-len(s1); i++ {
-			s[i] = 1
+				wg.Done()
+			}(l[min:max], r[min:max])
 		}
-		copy(s[len(s2)-len(s1):], s1)
-	} else {
-		s = s1
-	}
 
-	for i := 0; i < len(s2); i++ {
-		if s[i] != s2[i] && s[i] == 1 {
-			s[i] = s2[i]
-		}
+		wg.Wait()
+		return
 	}
 
-	return s
+	strides := [][]int{lhs.stride, rhs.broadcastStride(lhs.shape)}
+	handleLayouts(lhs.shape, strides, []int{lhs.offset, rhs.offset}, func(pos []int) {
+		lhs.data[pos[0]] = f(lhs.data[pos[0]], rhs.data[pos[1]])
+	}, nCPU)
 }
 
-// Zip performs an elementwise operation
-// between other and this Tensor.
+// Zip performs an elementwise operation between other and this Tensor,
+// both broadcast together. The other Tensor is broadcast without being
+// copied, while this Tensor is copied if it needs to be broadcast.
 func (t Tensor[T]) Zip(other any, f func(T, T) T) Tensor[T] {
 	if t.Err != nil {
 		if EnvConfig.Interactive {
@@ -75,35 +54,55 @@ func (t Tensor[T]) Zip(other any, f func(T, T) T) Tensor[T] {
 		}
 	}
 
-	o := From[T](other)
+	o, ok := other.(Tensor[T])
+	if !ok {
+		o = From[T](other)
+	}
+
 	if o.Err != nil {
 		if EnvConfig.Interactive {
-			panic(t.Err)
+			panic(o.Err)
 		} else {
 			t.Err = o.Err
 			return t
 		}
 	}
 
-	if !slices.Equal(t.shape, o.shape) {
-		if s := midwayBroadcast(o.shape, t.shape); t.Broadable(s...) && !slices.Equal(s, t.shape) {
-			t = t.Broadcast(s...)
+	shape, ok := broadcastShapes(t.shape, o.shape)
+	if !ok {
+		if EnvConfig.Interactive {
+			panic(ErrNotBroadable)
+		} else {
+			t.Err = ErrNotBroadable
+			return t
 		}
+	}
 
-		if s := midwayBroadcast(t.shape, o.shape); o.Broadable(s...) && !slices.Equal(s, o.shape) {
-			o = o.Broadcast(s...)
-		}
+	if !slices.Equal(shape, t.shape) {
+		t = Tensor[T]{
+			data:   t.data,
+			shape:  shape,
+			stride: t.broadcastStride(shape),
+			offset: t.offset,
+		}.materialize(shape...)
+	}
 
-		if !slices.Equal(t.shape, o.shape) {
-			if EnvConfig.Interactive {
-				panic(ErrNotBroadable)
-			} else {
-				t.Err = ErrNotBroadable
-				return t
-			}
+	err := verifyWritable(t.shape, t.stride)
+	if err != nil {
+		if EnvConfig.Interactive {
+			panic(err)
+		} else {
+			t.Err = err
+			return t
 		}
 	}
 
+	// the other Tensor might overlap with the Tensor,
+	// in which case it must be read before being overwritten
+	if len(o.data) > 0 && len(t.data) > 0 && &o.data[0] == &t.data[0] {
+		o = o.materialize(o.shape...)
+	}
+
 	handleZip(t, o, f, configCPU(t.Numel()))
 
 	return t
@@ -8,8 +8,26 @@ import (
 	"testing"
 
 	"github.com/vorduin/nune"
+	"github.com/vorduin/slices"
 )
 
+func TestZipOverlap(t *testing.T) {
+	a := nune.Range[int](0, 4, 1).Reshape(2, 2)
+	if !slices.Equal(a.Add(a.Permute(1, 0)).Ravel(), []int{0, 3, 3, 6}) {
+		t.Error("addition of a permuted view of the tensor is incorrect")
+	}
+
+	b := nune.Range[int](0, 4, 1)
+	if !slices.Equal(b.Add(b.Get(nune.S(nune.None, nune.None, -1))).Ravel(), []int{3, 3, 3, 3}) {
+		t.Error("addition of a reversed view of the tensor is incorrect")
+	}
+
+	x := nune.Range[int](0, 6, 1).Reshape(2, 3)
+	if !slices.Equal(x.Add(x.Rot90(2, [2]int{0, 1})).Ravel(), []int{5, 5, 5, 5, 5, 5}) {
+		t.Error("addition of a rotated view of the tensor is incorrect")
+	}
+}
+
 func BenchmarkAdd(b *testing.B) {
 	benchmarkOp(b, func(tensor nune.Tensor[TestsT]) {
 		tensor.Add(tensor)